@@ -0,0 +1,13 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_inventory" "all" {}
+
+output "all_workspace_sizes" {
+  value = {
+    for wg in data.singlestoredb_inventory.all.workspace_groups : wg.name => [for w in wg.workspaces : w.size]
+  }
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_private_connections" "all" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Replace with the actual ID of the workspace group.
+}
+
+output "pending_private_connections" {
+  value = [for pc in data.singlestoredb_private_connections.all.private_connections : pc.id if pc.status == "PENDING"]
+}
\ No newline at end of file
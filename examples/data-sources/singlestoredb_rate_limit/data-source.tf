@@ -0,0 +1,12 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_rate_limit" "current" {}
+
+output "remaining_requests" {
+  description = "The number of Management API requests remaining in the current rate limit window."
+  value       = data.singlestoredb_rate_limit.current.remaining
+}
\ No newline at end of file
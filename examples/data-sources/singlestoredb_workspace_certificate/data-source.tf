@@ -0,0 +1,17 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_workspace_certificate" "this" {
+  id = "26171125-ecb8-5944-9896-209fbffc1f15" # Replace with the actual ID of the workspace.
+}
+
+output "this_workspace_leaf_fingerprint" {
+  value = data.singlestoredb_workspace_certificate.this.fingerprints_sha256[0]
+}
+
+output "this_workspace_certificate_not_after" {
+  value = data.singlestoredb_workspace_certificate.this.not_after
+}
\ No newline at end of file
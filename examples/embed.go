@@ -9,13 +9,31 @@ import (
 var f embed.FS
 
 var (
-	Regions                       = mustRead("data-sources/singlestoredb_regions/data-source.tf")
-	WorkspaceGroupsListDataSource = mustRead("data-sources/singlestoredb_workspace_groups/data-source.tf")
-	WorkspaceGroupsGetDataSource  = mustRead("data-sources/singlestoredb_workspace_group/data-source.tf")
-	WorkspacesListDataSource      = mustRead("data-sources/singlestoredb_workspaces/data-source.tf")
-	WorkspacesGetDataSource       = mustRead("data-sources/singlestoredb_workspace/data-source.tf")
-	WorkspaceGroupsResource       = mustRead("resources/singlestoredb_workspace_group/resource.tf")
-	WorkspacesResource            = mustRead("resources/singlestoredb_workspace/resource.tf")
+	Regions                            = mustRead("data-sources/singlestoredb_regions/data-source.tf")
+	InventoryGetDataSource             = mustRead("data-sources/singlestoredb_inventory/data-source.tf")
+	RateLimitGetDataSource             = mustRead("data-sources/singlestoredb_rate_limit/data-source.tf")
+	WorkspaceGroupsListDataSource      = mustRead("data-sources/singlestoredb_workspace_groups/data-source.tf")
+	WorkspaceGroupsGetDataSource       = mustRead("data-sources/singlestoredb_workspace_group/data-source.tf")
+	WorkspaceGroupExportDataSource     = mustRead("data-sources/singlestoredb_workspace_group_export/data-source.tf")
+	WorkspacesListDataSource           = mustRead("data-sources/singlestoredb_workspaces/data-source.tf")
+	WorkspacesGetDataSource            = mustRead("data-sources/singlestoredb_workspace/data-source.tf")
+	WorkspaceConnectionDataSource      = mustRead("data-sources/singlestoredb_workspace_connection/data-source.tf")
+	WorkspaceHealthDataSource          = mustRead("data-sources/singlestoredb_workspace_health/data-source.tf")
+	WorkspaceCertificateDataSource     = mustRead("data-sources/singlestoredb_workspace_certificate/data-source.tf")
+	PrivateConnectionsListDataSource   = mustRead("data-sources/singlestoredb_private_connections/data-source.tf")
+	WorkspaceGroupsResource            = mustRead("resources/singlestoredb_workspace_group/resource.tf")
+	WorkspacesResource                 = mustRead("resources/singlestoredb_workspace/resource.tf")
+	WorkspaceFleetResource             = mustRead("resources/singlestoredb_workspace_fleet/resource.tf")
+	WorkspaceGroupWorkspacesResource   = mustRead("resources/singlestoredb_workspace_group_workspaces/resource.tf")
+	WorkspaceGroupPauseResource        = mustRead("resources/singlestoredb_workspace_group_pause/resource.tf")
+	WorkspaceGroupUpdateWindowResource = mustRead("resources/singlestoredb_workspace_group_update_window/resource.tf")
+	WorkspaceGroupFirewallRuleResource = mustRead("resources/singlestoredb_workspace_group_firewall_rule/resource.tf")
+	WorkspaceGroupFirewallResource     = mustRead("resources/singlestoredb_workspace_group_firewall/resource.tf")
+	WorkspaceGroupCloneResource        = mustRead("resources/singlestoredb_workspace_group_clone/resource.tf")
+	WorkspaceGroupSetResource          = mustRead("resources/singlestoredb_workspace_group_set/resource.tf")
+	SeedResource                       = mustRead("resources/singlestoredb_seed/resource.tf")
+	SQLScriptResource                  = mustRead("resources/singlestoredb_sql_script/resource.tf")
+	PrivateConnectionResource          = mustRead("resources/singlestoredb_private_connection/resource.tf")
 )
 
 func mustRead(path string) string {
@@ -0,0 +1,2 @@
+# Import a private connection by its ID.
+terraform import singlestoredb_private_connection.this 7d4b6e9a-31f0-4a8c-9c2e-5b7f2a8e1d43
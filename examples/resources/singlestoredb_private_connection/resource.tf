@@ -0,0 +1,15 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_private_connection" "this" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Replace with the actual ID of the workspace group.
+  type               = "INBOUND"
+  allow_list         = "123456789012" # Replace with the ID of the AWS account whose VPC endpoints to accept.
+}
+
+output "service_name" {
+  value = singlestoredb_private_connection.this.service_name # Create the VPC endpoint to this service.
+}
\ No newline at end of file
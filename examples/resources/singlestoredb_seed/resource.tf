@@ -0,0 +1,11 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_seed" "this" {
+  workspace_id = "26171125-ecb8-5944-9896-209fbffc1f15" # Replace with the actual ID of the workspace.
+  password     = "fooBAR12$"                            # Replace with the admin password of the workspace group.
+  sql          = "CREATE DATABASE IF NOT EXISTS demo; CREATE TABLE IF NOT EXISTS demo.items (id INT PRIMARY KEY);" // Prefer file("seed.sql") for longer scripts.
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication. 
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_sql_script" "this" {
+  workspace_id = "26171125-ecb8-5944-9896-209fbffc1f15" # Replace with the actual ID of the workspace.
+  password     = "fooBAR12$"                            # Replace with the admin password of the workspace group.
+  create_sql   = "CREATE DATABASE IF NOT EXISTS demo; CREATE ROWSTORE TABLE IF NOT EXISTS demo.settings (name TEXT PRIMARY KEY, value TEXT);"
+  destroy_sql  = "DROP TABLE IF EXISTS demo.settings;"
+  revision     = "1" # Increment to run the create script again.
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+# Import a workspace by its ID. With an import block instead, terraform plan -generate-config-out
+# generates the configuration.
+terraform import singlestoredb_workspace.this 26171125-ecb8-5944-9896-209fbffc1f15
@@ -0,0 +1,3 @@
+# Import a workspace group by its ID. With an import block instead, terraform plan -generate-config-out
+# generates the configuration, including the update window. The admin password is not returned by the API.
+terraform import singlestoredb_workspace_group.this 3ca3d359-021d-45ed-86cb-38b8d14ac507
@@ -0,0 +1,2 @@
+# Import the firewall of a workspace group by the ID of the workspace group. The imported firewall owns all the current ranges.
+terraform import singlestoredb_workspace_group_firewall.this bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d
\ No newline at end of file
@@ -0,0 +1,19 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group_firewall" "this" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Replace with the actual ID of the workspace group.
+  strategy           = "merge"
+
+  sources = {
+    static = ["10.0.0.0/8"]
+    office = ["192.0.2.0/24", "198.51.100.0/24"] # E.g., the ranges from a data source or the outputs of another module.
+  }
+}
+
+output "this_allowed_ranges" {
+  value = singlestoredb_workspace_group_firewall.this.ranges
+}
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Import a firewall rule by the ID of the workspace group and the CIDR range.
+terraform import singlestoredb_workspace_group_firewall_rule.office bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d/192.0.2.0/24
@@ -0,0 +1,15 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group_firewall_rule" "office" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Replace with the actual ID of the workspace group.
+  cidr               = "192.0.2.0/24"
+}
+
+resource "singlestoredb_workspace_group_firewall_rule" "ci" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Another module may contribute its own range to the same workspace group.
+  cidr               = "198.51.100.7/32"
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+variable "freeze" {
+  type    = bool
+  default = true
+}
+
+resource "singlestoredb_workspace_group_pause" "this" {
+  workspace_group_id = "bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d" # Replace with the actual ID of the workspace group.
+  suspended          = var.freeze // Set to false to resume the workspaces without destroying the resource.
+}
+
+output "suspended_workspace_ids" {
+  value = singlestoredb_workspace_group_pause.this.suspended_workspace_ids
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_regions" "all" {}
+
+resource "singlestoredb_workspace_group_set" "this" {
+  name_prefix     = "orders"
+  region_ids      = [for r in slice(data.singlestoredb_regions.all.regions, 0, 2) : r.id] // Prefer specifying the explicit region IDs in production environments as the list of regions may vary.
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+}
+
+output "workspace_group_ids" {
+  value = { for region_id, group in singlestoredb_workspace_group_set.this.workspace_groups : region_id => group.id }
+}
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Import the update window by the ID of the workspace group.
+terraform import singlestoredb_workspace_group_update_window.this bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d
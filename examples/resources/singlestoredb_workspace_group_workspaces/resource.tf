@@ -0,0 +1,30 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+data "singlestoredb_regions" "all" {}
+
+resource "singlestoredb_workspace_group" "example" {
+  name            = "group"
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+  region_id       = data.singlestoredb_regions.all.regions.0.id // Prefer specifying the explicit region ID in production environments as the list of regions may vary.
+}
+
+resource "singlestoredb_workspace" "example" {
+  name               = "workspace"
+  workspace_group_id = singlestoredb_workspace_group.example.id
+  size               = "S-00"
+}
+
+resource "singlestoredb_workspace_group_workspaces" "this" {
+  workspace_group_id         = singlestoredb_workspace_group.example.id
+  managed_workspace_ids      = [singlestoredb_workspace.example.id]
+  manage_existing_workspaces = "report" // Set to "adopt" to make Terraform own the workspaces created in the SingleStore Portal.
+}
+
+output "unmanaged_workspace_ids" {
+  value = singlestoredb_workspace_group_workspaces.this.unmanaged_workspace_ids
+}
\ No newline at end of file
@@ -11,6 +11,14 @@ const (
 	APIKeyAttribute = "api_key"
 	// APIServiceURLAttribute defines the Management API server URL part of the provider configuration.
 	APIServiceURLAttribute = "api_service_url"
+	// ReadOnlyAttribute defines the read-only mode as a part of the provider configuration.
+	ReadOnlyAttribute = "read_only"
+	// StateEncryptionPassphraseAttribute defines the passphrase for encrypting the generated secrets in the state.
+	StateEncryptionPassphraseAttribute = "state_encryption_passphrase"
+	// StrictScopesAttribute defines whether the reads requiring optional API key scopes fail as a part of the provider configuration.
+	StrictScopesAttribute = "strict_scopes"
+	// RegionsCacheTTLAttribute defines how long the listed regions are cached between runs as a part of the provider configuration.
+	RegionsCacheTTLAttribute = "regions_cache_ttl"
 	// IDAttribute is the idiomatic Terraform ID attribute.
 	IDAttribute = "id"
 	// WorkspaceGroupIDAttribute is the attribute of a workspace list data source.
@@ -19,30 +27,78 @@ const (
 	APIServiceURL = "https://api.singlestore.com"
 	// EnvAPIKey is the environmental variable for fetching the API key.
 	EnvAPIKey = "SINGLESTOREDB_API_KEY"
+	// EnvStateEncryptionPassphrase is the environmental variable for fetching the state encryption passphrase.
+	EnvStateEncryptionPassphrase = "SINGLESTOREDB_STATE_ENCRYPTION_PASSPHRASE"
+	// EnvCurrentIPServiceURL is the environmental variable for overriding the service that detects the public IP.
+	EnvCurrentIPServiceURL = "SINGLESTOREDB_CURRENT_IP_SERVICE_URL"
+	// EnvCABundleURL is the environmental variable for overriding the URL of the SingleStore CA bundle.
+	EnvCABundleURL = "SINGLESTOREDB_CA_BUNDLE_URL"
+	// CurrentIPServiceURL is the default service that responds with the public IP of the caller.
+	CurrentIPServiceURL = "https://checkip.amazonaws.com"
 	// ProviderName is the name of the provider.
 	ProviderName = "singlestoredb"
 	// HTTPRequestTimeout limits all the calls to Management API by 10 seconds.
 	HTTPRequestTimeout = time.Second * 10
+	// DataAPIRequestTimeout limits all the calls to the Data API of a workspace by 5 minutes.
+	DataAPIRequestTimeout = 5 * time.Minute
 	// WorkspaceGroupCreationTimeout limits the workspace group creation time.
 	WorkspaceGroupCreationTimeout = time.Hour
+	// WorkspaceGroupTerminationTimeout limits the workspace group termination time.
+	WorkspaceGroupTerminationTimeout = 30 * time.Minute
+	// WorkspaceTerminationTimeout limits the workspace termination time.
+	WorkspaceTerminationTimeout = 30 * time.Minute
 	// WorkspaceReadTimeout limits the workspace creation time.
 	WorkspaceReadTimeout = 10 * time.Minute
 	// WorkspaceCreationTimeout limits the workspace creation time.
 	WorkspaceCreationTimeout = 5 * time.Hour
 	// WorkspaceResumeTimeout limits the workspace resume time.
 	WorkspaceResumeTimeout = 6 * time.Hour
+	// WorkspaceSuspendTimeout limits the workspace suspension time.
+	WorkspaceSuspendTimeout = time.Hour
+	// WorkspaceHealthCheckTimeout limits the time of connecting to a workspace endpoint for a health check.
+	WorkspaceHealthCheckTimeout = 5 * time.Second
+	// WorkspaceTLSHandshakeTimeout limits the time of fetching the certificate chain of a workspace endpoint.
+	WorkspaceTLSHandshakeTimeout = 10 * time.Second
+	// WorkspaceResizeTimeout limits the workspace resize time unless the workspace resource sets resize_timeout.
+	WorkspaceResizeTimeout = 6 * time.Hour
+	// PrivateConnectionEstablishmentTimeout limits the time of waiting for a private connection to become active.
+	PrivateConnectionEstablishmentTimeout = time.Hour
 	// WorkspaceScaleTakesAtLeast ensures the least required time for scaling.
 	WorkspaceScaleTakesAtLeast = 30 * time.Second
 	// PortalAPIKeysPageRedirect redirects to the API keys page of the default organization.
 	PortalAPIKeysPageRedirect = "https://portal.singlestore.com/organizations/org-id/api-keys" //nolint:gosec
 	// SupportURL directs to SingleStore support.
 	SupportURL = "https://www.singlestore.com/support/"
+	// RegionsCacheDirName is the directory in the temporary directory where the regions are cached.
+	RegionsCacheDirName = "terraform-provider-singlestoredb"
 	// ProviderNewIssueURL  direct to creating a GitHub issue for the provider.
 	ProviderNewIssueURL = "https://github.com/singlestore-labs/terraform-provider-singlestoredb/issues/new"
 	// WorkspaceGroupConsistencyThreshold is the count of polling iterations where the state should equal the desired state.
 	WorkspaceGroupConsistencyThreshold = 5
 	// WorkspaceConsistencyThreshold is the count of polling iterations where the state should equal the desired state.
 	WorkspaceConsistencyThreshold = 5
+	// InventoryConcurrency limits the count of the concurrent calls to Management API for listing workspaces of workspace groups.
+	InventoryConcurrency = 8
+	// WorkspaceFleetConcurrency limits the count of the workspaces of a fleet that are created, scaled, or deleted concurrently.
+	WorkspaceFleetConcurrency = 8
+	// WorkspaceGroupSetConcurrency limits the count of the workspace groups of a set that are created, updated, or deleted concurrently.
+	WorkspaceGroupSetConcurrency = 8
+	// WorkspaceConnectionsPerVCPU is the count of the pooled connections per vCPU of a workspace that the recommended pool size allows.
+	WorkspaceConnectionsPerVCPU = 4
+	// CircuitBreakerThreshold is the count of the consecutive failed calls to Management API after which the calls fail fast.
+	CircuitBreakerThreshold = 5
+	// CircuitBreakerCooldown is the time after which a call to Management API is attempted again once the calls fail fast.
+	CircuitBreakerCooldown = time.Minute
+	// WorkspaceAdminUsername is the name of the admin SQL user of a workspace group.
+	WorkspaceAdminUsername = "admin"
+	// WorkspaceSQLPort is the port of the SQL endpoint of a workspace.
+	WorkspaceSQLPort = 3306
+	// WorkspaceHTTPSPort is the port of the HTTPS endpoint of a workspace, e.g., of the Data API.
+	WorkspaceHTTPSPort = 443
+	// WorkspaceTLS is the value of the tls argument of the mysql provider that enforces verified TLS to a workspace.
+	WorkspaceTLS = "true"
+	// SingleStoreCABundleURL is the URL of the CA bundle for verifying workspace certificates.
+	SingleStoreCABundleURL = "https://portal.singlestore.com/static/ca/singlestore_bundle.pem"
 
 	// TestIDValue indicates the value of the test only ID field.
 	TestIDValue = "internal"
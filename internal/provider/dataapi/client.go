@@ -0,0 +1,99 @@
+package dataapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const respReadLimit = int64(4096)
+
+// Client executes SQL statements on a workspace with the SingleStore Data API.
+type Client struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+type execRequest struct {
+	SQL      string `json:"sql"`
+	Database string `json:"database,omitempty"`
+}
+
+// URL returns the base URL of the Data API of the workspace endpoint.
+func URL(endpoint string) string {
+	return fmt.Sprintf("https://%s/api/v2", endpoint)
+}
+
+// NewClient creates a Data API client for the workspace endpoint.
+func NewClient(endpoint, username, password string) Client {
+	return NewClientWithURL(
+		fmt.Sprintf("https://%s", endpoint),
+		username,
+		password,
+		&http.Client{Timeout: config.DataAPIRequestTimeout},
+	)
+}
+
+// NewClientWithURL creates a Data API client for the base URL.
+func NewClientWithURL(baseURL, username, password string, httpClient *http.Client) Client {
+	return Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: httpClient,
+	}
+}
+
+// Exec executes a single SQL statement that does not return rows.
+//
+// Requests are not retried because statements are not necessarily idempotent.
+func (c Client) Exec(ctx context.Context, database, sql string) error {
+	body, err := json.Marshal(execRequest{SQL: sql, Database: database})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v2/exec", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call the Data API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, respReadLimit))
+
+		return fmt.Errorf("the Data API returned status code %s: %s", http.StatusText(resp.StatusCode), strings.TrimSpace(util.Redact(string(respBody))))
+	}
+
+	return nil
+}
+
+// ExecScript splits the script into statements and executes them one by one.
+//
+// It stops on the first failing statement.
+func (c Client) ExecScript(ctx context.Context, database, script string) error {
+	for i, statement := range SplitStatements(script) {
+		if err := c.Exec(ctx, database, statement); err != nil {
+			return fmt.Errorf("statement %d failed: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package dataapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/dataapi"
+	"github.com/stretchr/testify/require"
+)
+
+type execRequest struct {
+	SQL      string `json:"sql"`
+	Database string `json:"database"`
+}
+
+func TestExecScript(t *testing.T) {
+	executed := []execRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v2/exec", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		username, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "admin", username)
+		require.Equal(t, "secret", password)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input execRequest
+		require.NoError(t, json.Unmarshal(body, &input))
+		executed = append(executed, input)
+
+		_, err = w.Write([]byte(`{"lastInsertId":0,"rowsAffected":1}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	c := dataapi.NewClientWithURL(server.URL, "admin", "secret", server.Client())
+	require.NoError(t, c.ExecScript(context.Background(), "db", "CREATE TABLE t (a INT); INSERT INTO t VALUES (1);"))
+	require.Equal(t, []execRequest{
+		{SQL: "CREATE TABLE t (a INT)", Database: "db"},
+		{SQL: "INSERT INTO t VALUES (1)", Database: "db"},
+	}, executed)
+}
+
+func TestExecScriptStopsOnError(t *testing.T) {
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte("Error 1146: Table 'db.t' doesn't exist"))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	c := dataapi.NewClientWithURL(server.URL, "admin", "secret", server.Client())
+	err := c.ExecScript(context.Background(), "db", "INSERT INTO t VALUES (1); INSERT INTO t VALUES (2);")
+	require.ErrorContains(t, err, "statement 1 failed")
+	require.ErrorContains(t, err, "doesn't exist")
+	require.Equal(t, 1, calls)
+}
+
+func TestExecScriptRedactsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte("Error 1064: near 'IDENTIFIED BY 'fooBAR12$' WITH'"))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	c := dataapi.NewClientWithURL(server.URL, "admin", "secret", server.Client())
+	err := c.ExecScript(context.Background(), "db", "CREATE USER 'u'@'%' IDENTIFIED BY 'fooBAR12$' WITH;")
+	require.ErrorContains(t, err, "Error 1064")
+	require.NotContains(t, err.Error(), "fooBAR12$")
+}
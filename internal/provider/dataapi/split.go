@@ -0,0 +1,73 @@
+package dataapi
+
+import (
+	"strings"
+)
+
+// SplitStatements splits an SQL script into statements by semicolons.
+//
+// Semicolons within quoted strings, quoted identifiers, and comments are ignored.
+// Comments are dropped, and empty statements are skipped.
+func SplitStatements(script string) []string {
+	result := []string{}
+	current := strings.Builder{}
+	runes := []rune(script)
+
+	flush := func() {
+		if s := strings.TrimSpace(current.String()); s != "" {
+			result = append(result, s)
+		}
+
+		current.Reset()
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			end := quoteEnd(runes, i)
+			current.WriteString(string(runes[i:end]))
+			i = end - 1
+		case r == '#' || (r == '-' && i+1 < len(runes) && runes[i+1] == '-'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+
+			current.WriteRune('\n')
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && (runes[i] != '*' || runes[i+1] != '/') {
+				i++
+			}
+
+			i++ // Skipping the closing slash.
+			current.WriteRune(' ')
+		case r == ';':
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	return result
+}
+
+// quoteEnd returns the index right after the closing quote of the quote starting at begin.
+func quoteEnd(runes []rune, begin int) int {
+	quote := runes[begin]
+	for i := begin + 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && quote != '`':
+			i++ // Skipping the escaped character.
+		case runes[i] == quote && i+1 < len(runes) && runes[i+1] == quote:
+			i++ // Skipping the doubled quote.
+		case runes[i] == quote:
+			return i + 1
+		}
+	}
+
+	return len(runes)
+}
@@ -0,0 +1,34 @@
+package dataapi_test
+
+import (
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/dataapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	require.Empty(t, dataapi.SplitStatements(""))
+	require.Empty(t, dataapi.SplitStatements(";; ;\n"))
+	require.Equal(t, []string{"CREATE TABLE t (a INT)", "INSERT INTO t VALUES (1)"},
+		dataapi.SplitStatements("CREATE TABLE t (a INT);\nINSERT INTO t VALUES (1);"),
+	)
+	require.Equal(t, []string{"SELECT 1"}, dataapi.SplitStatements("SELECT 1"))
+}
+
+func TestSplitStatementsIgnoresQuotedSemicolons(t *testing.T) {
+	require.Equal(t, []string{"INSERT INTO t VALUES ('a;b')", "SELECT 1"},
+		dataapi.SplitStatements("INSERT INTO t VALUES ('a;b'); SELECT 1"),
+	)
+	require.Equal(t, []string{"SELECT 'it''s;'"}, dataapi.SplitStatements("SELECT 'it''s;'"))
+	require.Equal(t, []string{`SELECT "x\";"`}, dataapi.SplitStatements(`SELECT "x\";"`))
+	require.Equal(t, []string{"SELECT `a;b`"}, dataapi.SplitStatements("SELECT `a;b`"))
+	require.Equal(t, []string{"SELECT '/* not a comment */;'"}, dataapi.SplitStatements("SELECT '/* not a comment */;'"))
+}
+
+func TestSplitStatementsDropsComments(t *testing.T) {
+	require.Equal(t, []string{"SELECT 1", "SELECT 2"},
+		dataapi.SplitStatements("-- first; comment\nSELECT 1; # second; comment\nSELECT 2"),
+	)
+	require.Equal(t, []string{"SELECT 1"}, dataapi.SplitStatements("/* block; comment */ SELECT 1"))
+}
@@ -0,0 +1,62 @@
+package dataapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// NewClientForWorkspace looks up the endpoint of the active workspace and creates a Data API client for it.
+func NewClientForWorkspace(ctx context.Context, pd util.ProviderData, workspaceID uuid.UUID, username, password string) (Client, *util.SummaryWithDetailError) {
+	if pd.ReadOnly {
+		return Client{}, util.ReadOnlyError(fmt.Sprintf("refused to run SQL statements against the workspace %s", workspaceID))
+	}
+
+	workspace, err := pd.GetV1WorkspacesWorkspaceIDWithResponse(ctx, workspaceID, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err); serr != nil {
+		return Client{}, serr
+	}
+
+	if workspace.JSON200.State != management.WorkspaceStateACTIVE || workspace.JSON200.Endpoint == nil {
+		return Client{}, &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Workspace %s state is %s while it should be %s", workspaceID, workspace.JSON200.State, management.WorkspaceStateACTIVE),
+			Detail:  "SQL statements can only be executed on a workspace that has an endpoint. Resume the workspace and try again.",
+		}
+	}
+
+	return NewClient(*workspace.JSON200.Endpoint, username, password), nil
+}
+
+// WorkspaceExists reports whether the workspace is not terminated yet.
+//
+// The SQL resources forget the statements executed on a terminated workspace, so that they run again.
+func WorkspaceExists(ctx context.Context, pd util.ProviderData, workspaceID uuid.UUID) (bool, *util.SummaryWithDetailError) {
+	workspace, err := pd.GetV1WorkspacesWorkspaceIDWithResponse(ctx, workspaceID, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err, util.ReturnNilOnNotFound); serr != nil {
+		return false, serr
+	}
+
+	return workspace.JSON200 != nil && workspace.JSON200.State != management.WorkspaceStateTERMINATED, nil
+}
+
+// ExecScriptOnWorkspace executes the script on the active workspace statement by statement.
+func ExecScriptOnWorkspace(ctx context.Context, pd util.ProviderData, workspaceID uuid.UUID, username, password, database, script string) *util.SummaryWithDetailError {
+	c, cerr := NewClientForWorkspace(ctx, pd, workspaceID, username, password)
+	if cerr != nil {
+		return cerr
+	}
+
+	if err := c.ExecScript(ctx, database, script); err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to run the SQL script on the workspace %s", workspaceID),
+			Detail: "The statements before the failing one are already executed and are not rolled back. " +
+				"Fix the script to be idempotent or clean up manually before retrying.\n\n" +
+				"Data API error: " + err.Error(),
+		}
+	}
+
+	return nil
+}
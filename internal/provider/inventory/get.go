@@ -0,0 +1,250 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceGetName = "inventory"
+)
+
+// inventoryDataSourceGet is the data source implementation.
+type inventoryDataSourceGet struct {
+	util.ProviderData
+}
+
+// inventoryDataSourceModel maps the data source schema data.
+type inventoryDataSourceModel struct {
+	ID                  types.String                   `tfsdk:"id"`
+	WorkspaceGroupCount types.Int64                    `tfsdk:"workspace_group_count"`
+	WorkspaceCount      types.Int64                    `tfsdk:"workspace_count"`
+	WorkspaceGroups     []inventoryWorkspaceGroupModel `tfsdk:"workspace_groups"`
+	InsufficientScope   types.Bool                     `tfsdk:"insufficient_scope"`
+}
+
+type inventoryWorkspaceGroupModel struct {
+	ID         types.String              `tfsdk:"id"`
+	Name       types.String              `tfsdk:"name"`
+	RegionID   types.String              `tfsdk:"region_id"`
+	State      types.String              `tfsdk:"state"`
+	CreatedAt  types.String              `tfsdk:"created_at"`
+	ExpiresAt  types.String              `tfsdk:"expires_at"`
+	Workspaces []inventoryWorkspaceModel `tfsdk:"workspaces"`
+}
+
+type inventoryWorkspaceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Size      types.String `tfsdk:"size"`
+	State     types.String `tfsdk:"state"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+var _ datasource.DataSourceWithConfigure = &inventoryDataSourceGet{}
+
+// NewDataSourceGet is a helper function to simplify the provider implementation.
+func NewDataSourceGet() datasource.DataSource {
+	return &inventoryDataSourceGet{}
+}
+
+// Metadata returns the data source type name.
+func (d *inventoryDataSourceGet) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceGetName)
+}
+
+// Schema defines the schema for the data source.
+func (d *inventoryDataSourceGet) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides all the workspace groups that the user has access to together with their workspaces, e.g., for governance reports and drift scanners. The workspaces of different groups are listed concurrently.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_group_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of the workspace groups.",
+			},
+			"workspace_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of the workspaces across all the workspace groups.",
+			},
+			"insufficient_scope": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the API key lacks the scope for listing the workspaces of some workspace groups. The workspaces of such a group are null and not counted.",
+			},
+			"workspace_groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The workspace groups with their workspaces.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						config.IDAttribute: schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workspace group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workspace group.",
+						},
+						"region_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the region of the workspace group.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The state of the workspace group.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the workspace group was created.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The expiration timestamp of the workspace group, if any.",
+						},
+						"workspaces": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The workspaces of the workspace group.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									config.IDAttribute: schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The unique identifier of the workspace.",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the workspace.",
+									},
+									"size": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The size of the workspace.",
+									},
+									"state": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The state of the workspace.",
+									},
+									"endpoint": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The endpoint of the workspace, if it is active.",
+									},
+									"created_at": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The timestamp when the workspace was created.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inventoryDataSourceGet) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	workspaceGroups, err := d.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	if serr := util.StatusOK(workspaceGroups, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	groups := util.Deref(workspaceGroups.JSON200)
+	workspaces := make([][]management.Workspace, len(groups))
+	forbidden := make([]bool, len(groups))
+	serr := util.InParallel(len(groups), config.InventoryConcurrency, func(i int) *util.SummaryWithDetailError {
+		result, err := d.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{WorkspaceGroupID: groups[i].WorkspaceGroupID})
+		if serr := util.StatusOK(result, err, util.ReturnNilOnNotFound, util.TolerateInsufficientScope(d.StrictScopes)); serr != nil {
+			return serr
+		}
+
+		if util.IsInsufficientScope(result) {
+			forbidden[i] = true
+
+			return nil
+		}
+
+		workspaces[i] = util.Deref(result.JSON200) // Null for a group that got deleted since the listing.
+
+		return nil
+	})
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := inventoryDataSourceModel{
+		ID:                  types.StringValue(config.TestIDValue),
+		WorkspaceGroupCount: types.Int64Value(int64(len(groups))),
+		WorkspaceGroups:     make([]inventoryWorkspaceGroupModel, 0, len(groups)),
+		InsufficientScope:   types.BoolValue(util.Any(forbidden, true)),
+	}
+
+	workspaceCount := 0
+	for i, group := range groups {
+		workspaceCount += len(workspaces[i])
+		workspaceGroup := inventoryWorkspaceGroupModel{
+			ID:         util.UUIDStringValue(group.WorkspaceGroupID),
+			Name:       types.StringValue(group.Name),
+			RegionID:   util.UUIDStringValue(group.RegionID),
+			State:      util.WorkspaceGroupStateStringValue(group.State),
+			CreatedAt:  types.StringValue(group.CreatedAt),
+			ExpiresAt:  util.MaybeStringValue(group.ExpiresAt),
+			Workspaces: util.Map(workspaces[i], toInventoryWorkspaceModel),
+		}
+
+		if forbidden[i] {
+			workspaceGroup.Workspaces = nil // Unknown rather than none.
+		}
+
+		result.WorkspaceGroups = append(result.WorkspaceGroups, workspaceGroup)
+	}
+
+	result.WorkspaceCount = types.Int64Value(int64(workspaceCount))
+
+	if result.InsufficientScope.ValueBool() {
+		warning := util.InsufficientScopeWarning("the workspaces of some workspace groups")
+		resp.Diagnostics.AddWarning(
+			warning.Summary,
+			warning.Detail,
+		)
+	}
+
+	diags := resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *inventoryDataSourceGet) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+func toInventoryWorkspaceModel(workspace management.Workspace) inventoryWorkspaceModel {
+	return inventoryWorkspaceModel{
+		ID:        util.UUIDStringValue(workspace.WorkspaceID),
+		Name:      types.StringValue(workspace.Name),
+		Size:      types.StringValue(workspace.Size),
+		State:     util.WorkspaceStateStringValue(workspace.State),
+		Endpoint:  util.MaybeStringValue(workspace.Endpoint),
+		CreatedAt: types.StringValue(workspace.CreatedAt),
+	}
+}
@@ -0,0 +1,176 @@
+package inventory_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadsInventory(t *testing.T) {
+	workspaceGroups := []management.WorkspaceGroup{
+		{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             "foo",
+			RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			State:            management.ACTIVE,
+			WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+		},
+		{
+			CreatedAt:        "2023-03-28T05:33:06.3003Z",
+			ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+			Name:             "bar",
+			RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			State:            management.ACTIVE,
+			WorkspaceGroupID: uuid.MustParse("4ca3d359-021d-45ed-86cb-38b8d14ac507"),
+		},
+	}
+
+	workspaces := map[string][]management.Workspace{
+		workspaceGroups[0].WorkspaceGroupID.String(): {
+			{
+				CreatedAt:        "2023-02-28T05:33:06.3003Z",
+				Endpoint:         util.Ptr("svc-94a328d2-8c3d-412d-91a0-c32a750673cb-dml.aws-oregon-3.svc.singlestore.com"),
+				Name:             "first",
+				Size:             "S-00",
+				State:            management.WorkspaceStateACTIVE,
+				WorkspaceGroupID: workspaceGroups[0].WorkspaceGroupID,
+				WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+			},
+			{
+				CreatedAt:        "2023-02-28T05:33:06.3003Z",
+				Name:             "second",
+				Size:             "S-1",
+				State:            management.WorkspaceStateSUSPENDED,
+				WorkspaceGroupID: workspaceGroups[0].WorkspaceGroupID,
+				WorkspaceID:      uuid.MustParse("f1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+			},
+		},
+		workspaceGroups[1].WorkspaceGroupID.String(): {},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json") // Necessary to make the library parse the resulting JSON.
+
+		switch r.URL.Path {
+		case "/v1/workspaceGroups":
+			_, err := w.Write(testutil.MustJSON(workspaceGroups))
+			require.NoError(t, err)
+		case "/v1/workspaces":
+			result, ok := workspaces[r.URL.Query().Get("workspaceGroupID")]
+			require.True(t, ok)
+			_, err := w.Write(testutil.MustJSON(result))
+			require.NoError(t, err)
+		default:
+			require.Failf(t, "unexpected path", "path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.InventoryGetDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", config.IDAttribute, config.TestIDValue),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_group_count", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_count", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.workspaces.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.workspaces.0.endpoint", *workspaces[workspaceGroups[0].WorkspaceGroupID.String()][0].Endpoint),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.workspaces.1.state", string(management.WorkspaceStateSUSPENDED)),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.workspaces.1.endpoint"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.1.expires_at", config.TestInitialWorkspaceGroupExpiresAt),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.1.workspaces.#", "0"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "insufficient_scope", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadsInventoryInsufficientScope(t *testing.T) {
+	workspaceGroups := []management.WorkspaceGroup{
+		{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             "foo",
+			RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			State:            management.ACTIVE,
+			WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+		},
+		{
+			CreatedAt:        "2023-03-28T05:33:06.3003Z",
+			Name:             "bar",
+			RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			State:            management.ACTIVE,
+			WorkspaceGroupID: uuid.MustParse("4ca3d359-021d-45ed-86cb-38b8d14ac507"),
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch r.URL.Path {
+		case "/v1/workspaceGroups":
+			_, err := w.Write(testutil.MustJSON(workspaceGroups))
+			require.NoError(t, err)
+		case "/v1/workspaces":
+			if r.URL.Query().Get("workspaceGroupID") == workspaceGroups[1].WorkspaceGroupID.String() {
+				w.WriteHeader(http.StatusForbidden)
+
+				return
+			}
+
+			_, err := w.Write(testutil.MustJSON([]management.Workspace{
+				{
+					CreatedAt:        "2023-02-28T05:33:06.3003Z",
+					Name:             "first",
+					Size:             "S-00",
+					State:            management.WorkspaceStateSUSPENDED,
+					WorkspaceGroupID: workspaceGroups[0].WorkspaceGroupID,
+					WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+				},
+			}))
+			require.NoError(t, err)
+		default:
+			require.Failf(t, "unexpected path", "path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.InventoryGetDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "insufficient_scope", "true"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_group_count", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_count", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.0.workspaces.#", "1"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_inventory.all", "workspace_groups.1.workspaces.#"),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.InventoryGetDataSource).
+					WithStrictScopes(true).
+					String(),
+				ExpectError: regexp.MustCompile(http.StatusText(http.StatusForbidden)),
+			},
+		},
+	})
+}
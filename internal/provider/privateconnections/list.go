@@ -0,0 +1,175 @@
+package privateconnections
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceListName = "private_connections"
+)
+
+// privateConnectionsDataSourceList is the data source implementation.
+type privateConnectionsDataSourceList struct {
+	util.ProviderData
+}
+
+// privateConnectionsListDataSourceModel maps the data source schema data.
+type privateConnectionsListDataSourceModel struct {
+	ID                 types.String                       `tfsdk:"id"`
+	WorkspaceGroupID   types.String                       `tfsdk:"workspace_group_id"`
+	PrivateConnections []privateConnectionDataSourceModel `tfsdk:"private_connections"`
+}
+
+// privateConnectionDataSourceModel maps the private connection schema data.
+type privateConnectionDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
+	WorkspaceID      types.String `tfsdk:"workspace_id"`
+	Type             types.String `tfsdk:"type"`
+	AllowList        types.String `tfsdk:"allow_list"`
+	ServiceName      types.String `tfsdk:"service_name"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	Status           types.String `tfsdk:"status"`
+}
+
+var _ datasource.DataSourceWithConfigure = &privateConnectionsDataSourceList{}
+
+// NewDataSourceList is a helper function to simplify the provider implementation.
+func NewDataSourceList() datasource.DataSource {
+	return &privateConnectionsDataSourceList{}
+}
+
+// Metadata returns the data source type name.
+func (d *privateConnectionsDataSourceList) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceListName)
+}
+
+// Schema defines the schema for the data source.
+func (d *privateConnectionsDataSourceList) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides a list of the private connections of a workspace group, including the PENDING ones whose consumer endpoints are not accepted yet.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed: true,
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace group.",
+			},
+			DataSourceListName: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						config.IDAttribute: schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the private connection.",
+						},
+						config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workspace group of the private connection.",
+						},
+						"workspace_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workspace of the private connection if any.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The direction of the private connection, either INBOUND or OUTBOUND.",
+						},
+						"allow_list": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The account or the subscription ID whose consumer endpoints the connection accepts.",
+						},
+						"service_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the endpoint service.",
+						},
+						"endpoint": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The endpoint of the private connection.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the private connection, i.e., PENDING, ACTIVE, or DELETED.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *privateConnectionsDataSourceList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data privateConnectionsListDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.WorkspaceGroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.WorkspaceGroupIDAttribute),
+			"Invalid workspace group ID",
+			"The workspace group ID should be a valid UUID",
+		)
+
+		return
+	}
+
+	privateConnections, err := d.GetV1WorkspaceGroupsWorkspaceGroupIDPrivateConnectionsWithResponse(ctx, id,
+		&management.GetV1WorkspaceGroupsWorkspaceGroupIDPrivateConnectionsParams{},
+	)
+	if serr := util.StatusOK(privateConnections, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := privateConnectionsListDataSourceModel{
+		ID:                 types.StringValue(config.TestIDValue),
+		WorkspaceGroupID:   data.WorkspaceGroupID,
+		PrivateConnections: util.Map(util.Deref(privateConnections.JSON200), toPrivateConnectionDataSourceModel),
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *privateConnectionsDataSourceList) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+func toPrivateConnectionDataSourceModel(privateConnection management.PrivateConnection) privateConnectionDataSourceModel {
+	model := toPrivateConnectionResourceModel(privateConnection)
+
+	return privateConnectionDataSourceModel{
+		ID:               model.ID,
+		WorkspaceGroupID: model.WorkspaceGroupID,
+		WorkspaceID:      model.WorkspaceID,
+		Type:             model.Type,
+		AllowList:        model.AllowList,
+		ServiceName:      model.ServiceName,
+		Endpoint:         model.Endpoint,
+		Status:           model.Status,
+	}
+}
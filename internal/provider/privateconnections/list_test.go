@@ -0,0 +1,65 @@
+package privateconnections_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadsPrivateConnections(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+
+	privateConnections := []management.PrivateConnection{
+		{
+			AllowList:           util.Ptr("123456789012"),
+			PrivateConnectionID: uuid.MustParse("7d4b6e9a-31f0-4a8c-9c2e-5b7f2a8e1d43"),
+			ServiceName:         util.Ptr("com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890"),
+			Status:              util.Ptr(management.PrivateConnectionStatusACTIVE),
+			Type:                util.Ptr(management.PrivateConnectionTypeINBOUND),
+			WorkspaceGroupID:    workspaceGroupID,
+		},
+		{
+			PrivateConnectionID: uuid.MustParse("8e5c7f0b-42a1-4b9d-8d3f-6c8a3b9f2e54"),
+			ServiceName:         util.Ptr("com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890"),
+			Status:              util.Ptr(management.PrivateConnectionStatusPENDING),
+			Type:                util.Ptr(management.PrivateConnectionTypeINBOUND),
+			WorkspaceGroupID:    workspaceGroupID,
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups/"+workspaceGroupID.String()+"/privateConnections", r.URL.Path)
+		require.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(privateConnections))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.PrivateConnectionsListDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_private_connections.all", "private_connections.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_private_connections.all", "private_connections.0.allow_list", "123456789012"),
+					resource.TestCheckResourceAttr("data.singlestoredb_private_connections.all", "private_connections.0.status", "ACTIVE"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_private_connections.all", "private_connections.1.allow_list"),
+					resource.TestCheckResourceAttr("data.singlestoredb_private_connections.all", "private_connections.1.status", "PENDING"),
+				),
+			},
+		},
+	})
+}
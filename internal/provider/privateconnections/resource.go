@@ -0,0 +1,397 @@
+package privateconnections
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "private_connection"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &privateConnectionResource{}
+	_ resource.ResourceWithImportState = &privateConnectionResource{}
+)
+
+// privateConnectionResource is the resource implementation.
+type privateConnectionResource struct {
+	util.ProviderData
+}
+
+// privateConnectionResourceModel maps the resource schema data.
+type privateConnectionResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	WorkspaceGroupID   types.String `tfsdk:"workspace_group_id"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	Type               types.String `tfsdk:"type"`
+	AllowList          types.String `tfsdk:"allow_list"`
+	ServiceName        types.String `tfsdk:"service_name"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Status             types.String `tfsdk:"status"`
+	WaitForEstablished types.Bool   `tfsdk:"wait_for_established"`
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &privateConnectionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *privateConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+// Schema defines the schema for the resource.
+func (r *privateConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a private connection, e.g., an AWS PrivateLink, of a workspace group with this resource. For an inbound connection, the consumer endpoints of the accounts or the subscriptions in allow_list are accepted, so that the handshake completes without the SingleStore Portal.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the private connection.",
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace group of the private connection.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace to connect to privately. If not specified, the connection is to the workspace group.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The direction of the private connection, either INBOUND to the workspaces or OUTBOUND from them. If not specified, the Management API chooses it.",
+				Validators:          []validator.String{stringvalidator.OneOf(string(management.PrivateConnectionTypeINBOUND), string(management.PrivateConnectionTypeOUTBOUND))},
+			},
+			"allow_list": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The account or the subscription ID whose consumer endpoints the connection accepts, i.e., the account ID for AWS, the subscription ID for Azure, and the project name for GCP. Changing it accepts the endpoints of the new one in place.",
+			},
+			"service_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The name of the endpoint service. For an inbound connection, the Management API sets it, and the consumer endpoints connect to it.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The endpoint of the private connection.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the private connection, i.e., PENDING until a consumer endpoint is accepted, ACTIVE, or DELETED.",
+			},
+			"wait_for_established": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If true, creating and updating the private connection wait until its status is ACTIVE, so that the resources depending on it, e.g., DNS records and applications, are applied once the connectivity is live. The consumer endpoint should be created outside of the dependencies of the resource, or the wait never ends. Defaults to false.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *privateConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privateConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateConnectionCreateResponse, err := r.PostV1PrivateConnectionsWithResponse(ctx, management.PostV1PrivateConnectionsJSONRequestBody{
+		WorkspaceGroupID: uuid.MustParse(plan.WorkspaceGroupID.ValueString()),
+		WorkspaceID:      util.MaybeUUID(plan.WorkspaceID),
+		Type:             (*management.PrivateConnectionCreateType)(util.MaybeString(plan.Type)),
+		AllowList:        util.MaybeString(plan.AllowList),
+		ServiceName:      util.MaybeString(plan.ServiceName),
+	})
+	if serr := util.StatusOK(privateConnectionCreateResponse, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	id := privateConnectionCreateResponse.JSON200.PrivateConnectionID
+
+	var privateConnection management.PrivateConnection
+	var serr *util.SummaryWithDetailError
+	if plan.WaitForEstablished.ValueBool() {
+		privateConnection, serr = waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.PrivateConnectionEstablishmentTimeout)
+	} else {
+		privateConnection, serr = getPrivateConnection(ctx, r.ClientWithResponsesInterface, id)
+	}
+
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := toPrivateConnectionResourceModel(privateConnection)
+	result.AllowList = plan.AllowList // The Management API may take time to report the accepted consumers.
+	result.WaitForEstablished = plan.WaitForEstablished
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *privateConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state privateConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateConnection, err := r.GetV1PrivateConnectionsConnectionIDWithResponse(ctx, uuid.MustParse(state.ID.ValueString()),
+		&management.GetV1PrivateConnectionsConnectionIDParams{},
+	)
+	if serr := util.StatusOK(privateConnection, err, util.ReturnNilOnNotFound); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if privateConnection.JSON200 == nil || isDeleted(*privateConnection.JSON200) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	result := toPrivateConnectionResourceModel(*privateConnection.JSON200)
+	result.WaitForEstablished = state.WaitForEstablished
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// Only the allow list and wait_for_established are updated in place, the other attributes require replacement.
+func (r *privateConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state privateConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan privateConnectionResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := uuid.MustParse(plan.ID.ValueString())
+
+	if !plan.AllowList.Equal(state.AllowList) {
+		privateConnectionUpdateResponse, err := r.PatchV1PrivateConnectionsConnectionIDWithResponse(ctx, id,
+			management.PatchV1PrivateConnectionsConnectionIDJSONRequestBody{
+				AllowList: util.Ptr(plan.AllowList.ValueString()), // Empty removes the allow list.
+			},
+		)
+		if serr := util.StatusOK(privateConnectionUpdateResponse, err); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+	}
+
+	var privateConnection management.PrivateConnection
+	var serr *util.SummaryWithDetailError
+	if plan.WaitForEstablished.ValueBool() {
+		privateConnection, serr = waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.PrivateConnectionEstablishmentTimeout)
+	} else {
+		privateConnection, serr = getPrivateConnection(ctx, r.ClientWithResponsesInterface, id)
+	}
+
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := toPrivateConnectionResourceModel(privateConnection)
+	result.AllowList = plan.AllowList // The Management API may take time to report the accepted consumers.
+	result.WaitForEstablished = plan.WaitForEstablished
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *privateConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state privateConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateConnectionDeleteResponse, err := r.DeleteV1PrivateConnectionsConnectionIDWithResponse(ctx, uuid.MustParse(state.ID.ValueString()))
+	if serr := util.StatusOK(privateConnectionDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ImportState results in Terraform managing the resource that was not previously managed.
+func (r *privateConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(config.IDAttribute), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_established"), false)...)
+}
+
+func getPrivateConnection(ctx context.Context, c management.ClientWithResponsesInterface, id uuid.UUID) (management.PrivateConnection, *util.SummaryWithDetailError) {
+	privateConnection, err := c.GetV1PrivateConnectionsConnectionIDWithResponse(ctx, id, &management.GetV1PrivateConnectionsConnectionIDParams{})
+	if serr := util.StatusOK(privateConnection, err); serr != nil {
+		return management.PrivateConnection{}, serr
+	}
+
+	return *privateConnection.JSON200, nil
+}
+
+// waitStatusActive waits until the private connection is established, i.e., its status is ACTIVE.
+func waitStatusActive(ctx context.Context, c management.ClientWithResponsesInterface, id uuid.UUID, timeout time.Duration) (management.PrivateConnection, *util.SummaryWithDetailError) {
+	result := management.PrivateConnection{}
+
+	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		privateConnection, err := c.GetV1PrivateConnectionsConnectionIDWithResponse(ctx, id, &management.GetV1PrivateConnectionsConnectionIDParams{})
+		if err != nil { // Not status code OK does not get here, not retrying for that reason.
+			ferr := fmt.Errorf("failed to get private connection %s: %w", id, err)
+
+			return retry.NonRetryableError(ferr)
+		}
+
+		if code := privateConnection.StatusCode(); code == http.StatusUnauthorized {
+			return retry.NonRetryableError(util.StatusOK(privateConnection, nil)) // Retrying does not help with an expired API key.
+		}
+
+		if code := privateConnection.StatusCode(); code != http.StatusOK {
+			err := fmt.Errorf("failed to get private connection %s: status code %s", id, http.StatusText(code))
+
+			return retry.RetryableError(err)
+		}
+
+		if isDeleted(*privateConnection.JSON200) {
+			err := fmt.Errorf("private connection %s is deleted", id)
+
+			return retry.NonRetryableError(err)
+		}
+
+		if status := util.Deref(privateConnection.JSON200.Status); status != management.PrivateConnectionStatusACTIVE {
+			err := fmt.Errorf("private connection %s status is %s", id, status)
+
+			return retry.RetryableError(err)
+		}
+
+		result = *privateConnection.JSON200
+
+		return nil
+	}); err != nil {
+		return management.PrivateConnection{}, &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to wait for a private connection %s to be established", id),
+			Detail:  fmt.Sprintf("Private connection is not established: %s. Check that a consumer endpoint of the account or the subscription in allow_list connects to the endpoint service, or set wait_for_established to false.", err),
+		}
+	}
+
+	return result, nil
+}
+
+// isDeleted returns true if the private connection is deleted.
+func isDeleted(privateConnection management.PrivateConnection) bool {
+	return util.Deref(privateConnection.Status) == management.PrivateConnectionStatusDELETED
+}
+
+func toPrivateConnectionResourceModel(privateConnection management.PrivateConnection) privateConnectionResourceModel {
+	return privateConnectionResourceModel{
+		ID:               types.StringValue(privateConnection.PrivateConnectionID.String()),
+		WorkspaceGroupID: types.StringValue(privateConnection.WorkspaceGroupID.String()),
+		WorkspaceID:      util.MaybeUUIDStringValue(privateConnection.WorkspaceID),
+		Type:             util.MaybeStringValue((*string)(privateConnection.Type)),
+		AllowList:        allowListValue(privateConnection.AllowList),
+		ServiceName:      util.MaybeStringValue(privateConnection.ServiceName),
+		Endpoint:         util.MaybeStringValue(privateConnection.Endpoint),
+		Status:           types.StringValue(string(util.Deref(privateConnection.Status))),
+	}
+}
+
+// allowListValue returns null for the empty allow list, so that not specifying one does not show a difference.
+func allowListValue(allowList *string) types.String {
+	if util.Deref(allowList) == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*allowList)
+}
@@ -0,0 +1,201 @@
+package privateconnections_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDPrivateConnection(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	serviceName := "com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890"
+
+	mu := sync.Mutex{}
+	privateConnections := map[uuid.UUID]management.PrivateConnection{}
+
+	writeJSON := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(body))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1/privateConnections" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.PostV1PrivateConnectionsJSONRequestBody
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, workspaceGroupID, input.WorkspaceGroupID)
+			require.Equal(t, "123456789012", util.Deref(input.AllowList))
+
+			id := uuid.New()
+			privateConnections[id] = management.PrivateConnection{
+				AllowList:           input.AllowList,
+				PrivateConnectionID: id,
+				ServiceName:         util.Ptr(serviceName),
+				Status:              util.Ptr(management.PrivateConnectionStatusPENDING),
+				Type:                util.Ptr(management.PrivateConnectionTypeINBOUND),
+				WorkspaceGroupID:    input.WorkspaceGroupID,
+			}
+			writeJSON(w, struct{ PrivateConnectionID uuid.UUID }{PrivateConnectionID: id})
+		case strings.HasPrefix(r.URL.Path, "/v1/privateConnections/"):
+			id := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/v1/privateConnections/"))
+			privateConnection, ok := privateConnections[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, privateConnection)
+			case http.MethodPatch:
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var input management.PatchV1PrivateConnectionsConnectionIDJSONRequestBody
+				require.NoError(t, json.Unmarshal(body, &input))
+				privateConnection.AllowList = input.AllowList
+				privateConnections[id] = privateConnection
+				writeJSON(w, struct{ PrivateConnectionID uuid.UUID }{PrivateConnectionID: id})
+			case http.MethodDelete:
+				privateConnection.Status = util.Ptr(management.PrivateConnectionStatusDELETED)
+				privateConnections[id] = privateConnection
+				writeJSON(w, struct{ PrivateConnectionID uuid.UUID }{PrivateConnectionID: id})
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotImplemented)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	statuses := func() []management.PrivateConnectionStatus {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result := []management.PrivateConnectionStatus{}
+		for _, privateConnection := range privateConnections {
+			result = append(result, util.Deref(privateConnection.Status))
+		}
+
+		return result
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.PrivateConnectionResource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("singlestoredb_private_connection.this", config.IDAttribute),
+					resource.TestCheckResourceAttr("singlestoredb_private_connection.this", "type", string(management.PrivateConnectionTypeINBOUND)),
+					resource.TestCheckResourceAttr("singlestoredb_private_connection.this", "service_name", serviceName),
+					resource.TestCheckResourceAttr("singlestoredb_private_connection.this", "status", string(management.PrivateConnectionStatusPENDING)),
+					resource.TestCheckOutput("service_name", serviceName),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.PrivateConnectionResource).
+					WithPrivateConnectionResource("this")("allow_list", cty.StringVal("210987654321")).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_private_connection.this", "allow_list", "210987654321"),
+			},
+			{
+				PreConfig: func() {
+					require.Len(t, statuses(), 1, "should accept the new account in place")
+				},
+				ResourceName:      "singlestoredb_private_connection.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	require.Equal(t, []management.PrivateConnectionStatus{management.PrivateConnectionStatusDELETED}, statuses())
+}
+
+func TestPrivateConnectionWaitForEstablished(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	id := uuid.MustParse("7d4b6e9a-31f0-4a8c-9c2e-5b7f2a8e1d43")
+
+	mu := sync.Mutex{}
+	pendingReads := 2
+	privateConnection := management.PrivateConnection{
+		AllowList:           util.Ptr("123456789012"),
+		PrivateConnectionID: id,
+		ServiceName:         util.Ptr("com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890"),
+		Status:              util.Ptr(management.PrivateConnectionStatusPENDING),
+		Type:                util.Ptr(management.PrivateConnectionTypeINBOUND),
+		WorkspaceGroupID:    workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/privateConnections" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ PrivateConnectionID uuid.UUID }{PrivateConnectionID: id}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/privateConnections/"+id.String() && r.Method == http.MethodGet:
+			if pendingReads == 0 { // The consumer endpoint is accepted after a few polls.
+				privateConnection.Status = util.Ptr(management.PrivateConnectionStatusACTIVE)
+			}
+
+			pendingReads--
+			_, err := w.Write(testutil.MustJSON(privateConnection))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/privateConnections/"+id.String() && r.Method == http.MethodDelete:
+			privateConnection.Status = util.Ptr(management.PrivateConnectionStatusDELETED)
+			_, err := w.Write(testutil.MustJSON(struct{ PrivateConnectionID uuid.UUID }{PrivateConnectionID: id}))
+			require.NoError(t, err)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.PrivateConnectionResource).
+					WithPrivateConnectionResource("this")("wait_for_established", cty.BoolVal(true)).
+					String(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_private_connection.this", config.IDAttribute, id.String()),
+					resource.TestCheckResourceAttr("singlestoredb_private_connection.this", "status", string(management.PrivateConnectionStatusACTIVE)),
+				),
+			},
+		},
+	})
+}
@@ -11,10 +11,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/inventory"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/privateconnections"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/ratelimit"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/regions"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/seeds"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sqlscripts"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/workspacegroups"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/workspaces"
@@ -27,9 +33,13 @@ type singlestoreProvider struct {
 
 // singlestoreProviderModel maps provider schema data to a Go type.
 type singlestoreProviderModel struct {
-	APIKey        types.String `tfsdk:"api_key"`
-	APIKeyPath    types.String `tfsdk:"api_key_path"`
-	APIServiceURL types.String `tfsdk:"api_service_url"`
+	APIKey                    types.String `tfsdk:"api_key"`
+	APIKeyPath                types.String `tfsdk:"api_key_path"`
+	APIServiceURL             types.String `tfsdk:"api_service_url"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	RegionsCacheTTL           types.String `tfsdk:"regions_cache_ttl"`
+	StateEncryptionPassphrase types.String `tfsdk:"state_encryption_passphrase"`
+	StrictScopes              types.Bool   `tfsdk:"strict_scopes"`
 }
 
 var (
@@ -71,6 +81,24 @@ func (p *singlestoreProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Optional:            true,
 				DeprecationMessage:  "The use of the API service URL is now optional and is intended for testing purposes only.",
 			},
+			config.ReadOnlyAttribute: schema.BoolAttribute{
+				MarkdownDescription: "If true, the provider never issues write calls, neither to the Management API nor to the Data API of the workspaces. Refreshing and planning work as usual while applying any change fails, which suits scheduled drift detection with read-only credentials. Defaults to false.",
+				Optional:            true,
+			},
+			config.RegionsCacheTTLAttribute: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long the regions that the provider lists are kept between runs as a duration, e.g., \"24h\", since they change rarely. The regions are cached in a file in the '%s' directory of the temporary directory, separately for every API key, and both the singlestoredb_regions data source and the resolution of region names read through the cache. If not provided, the regions are listed on every run.", config.RegionsCacheDirName),
+				Optional:            true,
+				Validators:          []validator.String{util.NewDurationValidator()},
+			},
+			config.StateEncryptionPassphraseAttribute: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The passphrase for keeping the secrets that the SingleStore API generates, e.g., the admin password of a workspace group, only encrypted in the state, so that a leaked state file does not expose live credentials. The secrets are encrypted with AES-256-GCM using a key derived from the passphrase with scrypt, and the encrypted attributes describe how to decrypt them. The secrets that are set in the configuration are stored by Terraform as is. If not provided, the provider will use the '%s' environment variable.", config.EnvStateEncryptionPassphrase),
+				Optional:            true,
+				Sensitive:           true,
+			},
+			config.StrictScopesAttribute: schema.BoolAttribute{
+				MarkdownDescription: "If true, the auxiliary reads of the data sources, e.g., the workspaces of the inventory, fail if the API key lacks the scope for them. Otherwise, such a data source warns, sets its insufficient_scope attribute to true, and leaves the data that it could not read null, so that a narrowly scoped API key does not fail the entire plan. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -122,9 +150,17 @@ func (p *singlestoreProvider) Configure(ctx context.Context, req provider.Config
 		apiServiceURL = conf.APIServiceURL.ValueString()
 	}
 
+	readOnly := conf.ReadOnly.ValueBool()
+
 	client, err := management.NewClientWithResponses(apiServiceURL,
 		management.WithHTTPClient(util.NewHTTPClient()),
 		management.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if readOnly {
+				if err := util.RejectWrites(ctx, req); err != nil {
+					return err
+				}
+			}
+
 			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 			req.Header.Set("User-Agent", util.TerraformProviderUserAgent(p.version))
 
@@ -143,29 +179,69 @@ func (p *singlestoreProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Make the SingleStore client available during DataSource and Resource
+	providerData := util.ProviderData{
+		ClientWithResponsesInterface: client,
+		ReadOnly:                     readOnly,
+		StrictScopes:                 conf.StrictScopes.ValueBool(),
+		StateEncryptionPassphrase:    util.FirstNotEmpty(conf.StateEncryptionPassphrase.ValueString(), os.Getenv(config.EnvStateEncryptionPassphrase)),
+	}
+
+	if !conf.RegionsCacheTTL.IsNull() {
+		ttl, err := util.ParseDuration(conf.RegionsCacheTTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(config.RegionsCacheTTLAttribute),
+				"Invalid regions cache TTL",
+				err.Error(),
+			)
+
+			return
+		}
+
+		providerData.RegionsCache = util.NewRegionsCache(apiServiceURL, apiKey, ttl)
+	}
+
+	// Make the SingleStore client and the settings available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *singlestoreProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return util.Map([]func() datasource.DataSource{
 		regions.NewDataSourceList,
+		inventory.NewDataSourceGet,
+		ratelimit.NewDataSourceGet,
 		workspacegroups.NewDataSourceList,
 		workspacegroups.NewDataSourceGet,
+		workspacegroups.NewDataSourceExport,
 		workspaces.NewDataSourceList,
 		workspaces.NewDataSourceGet,
-	}
+		workspaces.NewDataSourceConnection,
+		workspaces.NewDataSourceHealth,
+		workspaces.NewDataSourceCertificate,
+		privateconnections.NewDataSourceList,
+	}, util.DataSourceWithDeprecationWarnings)
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *singlestoreProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return util.Map([]func() resource.Resource{
 		workspacegroups.NewResource,
+		workspacegroups.NewResourceUpdateWindow,
+		workspacegroups.NewResourceFirewallRule,
+		workspacegroups.NewResourceFirewall,
+		workspacegroups.NewResourceClone,
+		workspacegroups.NewResourceSet,
 		workspaces.NewResource,
-	}
+		workspaces.NewResourceFleet,
+		workspaces.NewResourceGroupWorkspaces,
+		workspaces.NewResourcePause,
+		seeds.NewResource,
+		sqlscripts.NewResource,
+		privateconnections.NewResource,
+	}, util.ResourceWithDeprecationWarnings)
 }
 
 // ValidateConfig asserts that incompatible fields are not specified.
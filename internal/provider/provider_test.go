@@ -222,3 +222,27 @@ func TestProviderAuthenticatesIntegration(t *testing.T) {
 		},
 	})
 }
+
+func TestProviderReadOnlyRefusesWrites(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method, "should not get here because the provider is read-only")
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write([]byte(`[{"regionID": "2ca3d358-021d-45ed-86cb-38b8d14ac507", "region": "US West 2 (Oregon)", "provider": "AWS"}]`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithReadOnly(true).
+					String(),
+				ExpectError: regexp.MustCompile("SingleStore provider is read-only"),
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceGetName = "rate_limit"
+)
+
+var (
+	limitHeaders     = []string{"X-RateLimit-Limit", "RateLimit-Limit"}
+	remainingHeaders = []string{"X-RateLimit-Remaining", "RateLimit-Remaining"}
+	resetHeaders     = []string{"X-RateLimit-Reset", "RateLimit-Reset"}
+)
+
+// rateLimitDataSourceGet is the data source implementation.
+type rateLimitDataSourceGet struct {
+	util.ProviderData
+}
+
+// rateLimitDataSourceModel maps the data source schema data.
+type rateLimitDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Limit             types.Int64  `tfsdk:"limit"`
+	Remaining         types.Int64  `tfsdk:"remaining"`
+	Reset             types.String `tfsdk:"reset"`
+	InsufficientScope types.Bool   `tfsdk:"insufficient_scope"`
+}
+
+var _ datasource.DataSourceWithConfigure = &rateLimitDataSourceGet{}
+
+// NewDataSourceGet is a helper function to simplify the provider implementation.
+func NewDataSourceGet() datasource.DataSource {
+	return &rateLimitDataSourceGet{}
+}
+
+// Metadata returns the data source type name.
+func (d *rateLimitDataSourceGet) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceGetName)
+}
+
+// Schema defines the schema for the data source.
+func (d *rateLimitDataSourceGet) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the current rate limit status of the Management API for the API key in use, as reported by the API response headers. Attributes are not set if the API does not report them.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed: true,
+			},
+			"limit": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The maximum number of requests permitted in the current rate limit window.",
+			},
+			"remaining": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of requests remaining in the current rate limit window.",
+			},
+			"reset": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The raw value of the rate limit reset header, indicating when the current rate limit window resets.",
+			},
+			"insufficient_scope": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the API key lacks the scope for the call that reports the rate limit status. The other attributes are not set then.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rateLimitDataSourceGet) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	regions, err := d.GetV1RegionsWithResponse(ctx, &management.GetV1RegionsParams{}) // The cheapest call to get the headers.
+	if serr := util.StatusOK(regions, err, util.TolerateInsufficientScope(d.StrictScopes)); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := toRateLimitDataSourceModel(regions.HTTPResponse.Header)
+	if util.IsInsufficientScope(regions) {
+		result = rateLimitDataSourceModel{
+			ID:                types.StringValue(config.TestIDValue),
+			Limit:             types.Int64Null(),
+			Remaining:         types.Int64Null(),
+			Reset:             types.StringNull(),
+			InsufficientScope: types.BoolValue(true),
+		}
+
+		warning := util.InsufficientScopeWarning("the rate limit status")
+		resp.Diagnostics.AddWarning(
+			warning.Summary,
+			warning.Detail,
+		)
+	}
+
+	diags := resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rateLimitDataSourceGet) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+func toRateLimitDataSourceModel(header http.Header) rateLimitDataSourceModel {
+	return rateLimitDataSourceModel{
+		ID:                types.StringValue(config.TestIDValue),
+		Limit:             maybeInt64Header(header, limitHeaders),
+		Remaining:         maybeInt64Header(header, remainingHeaders),
+		Reset:             util.MaybeStringValue(maybeHeader(header, resetHeaders)),
+		InsufficientScope: types.BoolValue(false),
+	}
+}
+
+func maybeHeader(header http.Header, names []string) *string {
+	for _, name := range names {
+		if value := header.Get(name); value != "" {
+			return &value
+		}
+	}
+
+	return nil
+}
+
+func maybeInt64Header(header http.Header, names []string) types.Int64 {
+	value := maybeHeader(header, names)
+	if value == nil {
+		return types.Int64Null()
+	}
+
+	result, err := strconv.ParseInt(*value, 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(result)
+}
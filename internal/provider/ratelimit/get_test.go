@@ -0,0 +1,134 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+var regions = []management.Region{
+	{
+		RegionID: uuid.MustParse("e495c7f3-b37a-4234-8e8f-f715257e3a6c"),
+		Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+		Provider: management.AWS,
+	},
+}
+
+func TestReadsRateLimit(t *testing.T) {
+	reset := "1700000000"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/regions", r.URL.Path)
+		w.Header().Add("Content-Type", "json") // Necessary to make the library parse the resulting JSON.
+		w.Header().Add("X-RateLimit-Limit", "100")
+		w.Header().Add("X-RateLimit-Remaining", "42")
+		w.Header().Add("X-RateLimit-Reset", reset)
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.RateLimitGetDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", config.IDAttribute, config.TestIDValue),
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", "limit", "100"),
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", "remaining", "42"),
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", "reset", reset),
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", "insufficient_scope", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadsRateLimitWithoutHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/regions", r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+		w.Header().Add("X-RateLimit-Remaining", "not a number")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.RateLimitGetDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", config.IDAttribute, config.TestIDValue),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "limit"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "remaining"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "reset"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadRateLimitError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        "bar",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.RateLimitGetDataSource,
+				ExpectError: regexp.MustCompile(http.StatusText(http.StatusUnauthorized)),
+			},
+		},
+	})
+}
+
+func TestReadRateLimitInsufficientScope(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.RateLimitGetDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_rate_limit.current", "insufficient_scope", "true"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "limit"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "remaining"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_rate_limit.current", "reset"),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.RateLimitGetDataSource).
+					WithStrictScopes(true).
+					String(),
+				ExpectError: regexp.MustCompile(http.StatusText(http.StatusForbidden)),
+			},
+		},
+	})
+}
@@ -17,7 +17,7 @@ const (
 
 // regionsDataSourceList is the data source implementation.
 type regionsDataSourceList struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // regionsListDataSourceModel maps the data source schema data.
@@ -78,19 +78,25 @@ func (d *regionsDataSourceList) Schema(_ context.Context, _ datasource.SchemaReq
 
 // Read refreshes the Terraform state with the latest data.
 func (d *regionsDataSourceList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	regions, err := d.GetV1RegionsWithResponse(ctx, &management.GetV1RegionsParams{})
-	if serr := util.StatusOK(regions, err, util.ReturnNilOnNotFound); serr != nil {
-		resp.Diagnostics.AddError(
-			serr.Summary,
-			serr.Detail,
-		)
-
-		return
+	cached, ok := d.RegionsCache.Load()
+	if !ok {
+		regions, err := d.GetV1RegionsWithResponse(ctx, &management.GetV1RegionsParams{})
+		if serr := util.StatusOK(regions, err, util.ReturnNilOnNotFound); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		cached = util.Deref(regions.JSON200)
+		d.RegionsCache.Store(cached)
 	}
 
 	result := regionsListDataSourceModel{
 		ID:      types.StringValue(config.TestIDValue),
-		Regions: util.Map(util.Deref(regions.JSON200), toRegionsDataSourceModel),
+		Regions: util.Map(cached, toRegionsDataSourceModel),
 	}
 
 	diags := resp.State.Set(ctx, &result)
@@ -103,7 +109,7 @@ func (d *regionsDataSourceList) Configure(_ context.Context, req datasource.Conf
 		return // Should not return an error for unknown reasons.
 	}
 
-	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 func toRegionsDataSourceModel(region management.Region) regionModel {
@@ -96,3 +96,66 @@ func TestReadsRegionsIntegration(t *testing.T) {
 		},
 	})
 }
+
+func TestReadsCachedRegions(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("e495c7f3-b37a-4234-8e8f-f715257e3a6c"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/regions", r.URL.Path)
+		calls++
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	cached := testutil.UpdatableConfig(examples.Regions).
+		WithRegionsCacheTTL("1h").
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: cached,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_regions.all", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_regions.all", fmt.Sprintf("regions.0.%s", config.IDAttribute), regions[0].RegionID.String()),
+				),
+			},
+			{
+				Config: cached,
+				Check:  resource.TestCheckResourceAttr("data.singlestoredb_regions.all", "regions.#", "1"),
+			},
+		},
+	})
+
+	require.Equal(t, 1, calls, "should list the regions once within the TTL")
+}
+
+func TestRegionsCacheTTLInvalid(t *testing.T) {
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: "http://localhost:1",
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.Regions).
+					WithRegionsCacheTTL("tomorrow").
+					String(),
+				ExpectError: regexp.MustCompile(config.RegionsCacheTTLAttribute),
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package seeds
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/dataapi"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "seed"
+)
+
+var (
+	_ resource.ResourceWithConfigure  = &seedResource{}
+	_ resource.ResourceWithModifyPlan = &seedResource{}
+)
+
+// seedResource is the resource implementation.
+type seedResource struct {
+	util.ProviderData
+}
+
+// seedResourceModel maps the resource schema data.
+type seedResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Database    types.String `tfsdk:"database"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	SQL         types.String `tfsdk:"sql"`
+	Checksum    types.String `tfsdk:"checksum"`
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &seedResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *seedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+// Schema defines the schema for the resource.
+func (r *seedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Load an SQL script into a workspace with the Data API at creation time. Changing the checksum of the script, the workspace, or the database re-runs the script. Destroying the resource does not revert the script.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the seed.",
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace to load the script into. The workspace should be active.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The database to run the script in. If not specified, the script should qualify the objects with database names.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(config.WorkspaceAdminUsername),
+				MarkdownDescription: fmt.Sprintf("The SQL user to run the script as. Defaults to '%s'.", config.WorkspaceAdminUsername),
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the SQL user, e.g., the admin password of the workspace group.",
+			},
+			"sql": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The SQL script separated by semicolons, e.g., file(\"seed.sql\"). The statements are executed one by one, stopping at the first failure.",
+			},
+			"checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the script. A different checksum replaces the seed, running the script again.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *seedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan seedResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serr := dataapi.ExecScriptOnWorkspace(ctx, r.ProviderData,
+		uuid.MustParse(plan.WorkspaceID.ValueString()),
+		plan.Username.ValueString(),
+		plan.Password.ValueString(),
+		plan.Database.ValueString(),
+		plan.SQL.ValueString(),
+	); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.Checksum = types.StringValue(checksum(plan.SQL.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *seedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state seedResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exists, serr := dataapi.WorkspaceExists(ctx, r.ProviderData, uuid.MustParse(state.WorkspaceID.ValueString()))
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !exists {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace got terminated, deleting the seed from the state file to load it again.
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// Only the credentials of the same script can change without replacement,
+// so the script is not executed again.
+func (r *seedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan seedResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+// The loaded data is left as is.
+func (r *seedResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *seedResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ModifyPlan computes the checksum of the script, so that a different script replaces the seed.
+//
+// The checksum is unknown if the script is not known until apply, which replaces the seed too.
+func (r *seedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *seedResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	var state *seedResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Checksum = types.StringUnknown()
+	if !plan.SQL.IsUnknown() {
+		plan.Checksum = types.StringValue(checksum(plan.SQL.ValueString()))
+	}
+
+	if state != nil && !plan.Checksum.Equal(state.Checksum) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("checksum"))
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func checksum(script string) string {
+	sum := sha256.Sum256([]byte(script))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,246 @@
+package sqlscripts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/dataapi"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "sql_script"
+)
+
+var _ resource.ResourceWithConfigure = &sqlScriptResource{}
+
+// sqlScriptResource is the resource implementation.
+type sqlScriptResource struct {
+	util.ProviderData
+}
+
+// sqlScriptResourceModel maps the resource schema data.
+type sqlScriptResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Database    types.String `tfsdk:"database"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	CreateSQL   types.String `tfsdk:"create_sql"`
+	DestroySQL  types.String `tfsdk:"destroy_sql"`
+	Revision    types.String `tfsdk:"revision"`
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &sqlScriptResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *sqlScriptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+// Schema defines the schema for the resource.
+func (r *sqlScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Run idempotent SQL against a workspace with the Data API. Use this resource for database objects that the provider does not manage yet. The create script runs on creation and whenever the create script or the revision changes. The destroy script runs on deletion.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the SQL script.",
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace to run the scripts against. The workspace should be active.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The database to run the scripts in. If not specified, the scripts should qualify the objects with database names.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(config.WorkspaceAdminUsername),
+				MarkdownDescription: fmt.Sprintf("The SQL user to run the scripts as. Defaults to '%s'.", config.WorkspaceAdminUsername),
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the SQL user, e.g., the admin password of the workspace group.",
+			},
+			"create_sql": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The SQL script that creates the objects, e.g., CREATE TABLE IF NOT EXISTS. The script should be idempotent since it runs again whenever it or the revision changes.",
+			},
+			"destroy_sql": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The SQL script that drops the objects on deletion, e.g., DROP TABLE IF EXISTS. If not specified, deleting the resource leaves the objects as is.",
+			},
+			"revision": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An arbitrary value that runs the create script again when changed, e.g., a version number or a hash of the objects the script depends on.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sqlScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sqlScriptResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serr := r.exec(ctx, plan, plan.CreateSQL.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sqlScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sqlScriptResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exists, serr := dataapi.WorkspaceExists(ctx, r.ProviderData, uuid.MustParse(state.WorkspaceID.ValueString()))
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !exists {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace got terminated, deleting the script from the state file to run it again.
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// The create script runs again only if either it or the revision changes.
+func (r *sqlScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state sqlScriptResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan sqlScriptResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CreateSQL.Equal(state.CreateSQL) || !plan.Revision.Equal(state.Revision) {
+		if serr := r.exec(ctx, plan, plan.CreateSQL.ValueString()); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+// The destroy script is skipped if the workspace is already terminated.
+func (r *sqlScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sqlScriptResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DestroySQL.IsNull() {
+		return
+	}
+
+	exists, serr := dataapi.WorkspaceExists(ctx, r.ProviderData, uuid.MustParse(state.WorkspaceID.ValueString()))
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	if serr := r.exec(ctx, state, state.DestroySQL.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sqlScriptResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+func (r *sqlScriptResource) exec(ctx context.Context, model sqlScriptResourceModel, script string) *util.SummaryWithDetailError {
+	return dataapi.ExecScriptOnWorkspace(ctx, r.ProviderData,
+		uuid.MustParse(model.WorkspaceID.ValueString()),
+		model.Username.ValueString(),
+		model.Password.ValueString(),
+		model.Database.ValueString(),
+		script,
+	)
+}
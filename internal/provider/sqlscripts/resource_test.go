@@ -0,0 +1,73 @@
+package sqlscripts_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestSQLScriptRequiresActiveWorkspace(t *testing.T) {
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateSUSPENDED,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLScriptResource).
+					WithSQLScriptResource("this")("workspace_id", cty.StringVal(workspace.WorkspaceID.String())).
+					String(),
+				ExpectError: regexp.MustCompile("Resume the workspace"),
+			},
+		},
+	})
+}
+
+func TestSQLScriptInvalidWorkspaceID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.False(t, true, "should not get here")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLScriptResource).
+					WithSQLScriptResource("this")("workspace_id", cty.StringVal("invalid-uuid")).
+					String(),
+				ExpectError: regexp.MustCompile("invalid UUID"),
+			},
+		},
+	})
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/privateconnections"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/seeds"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sqlscripts"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/workspacegroups"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/workspaces"
 	"github.com/zclconf/go-cty/cty"
@@ -23,10 +26,26 @@ func (uc UpdatableConfig) WithWorkspaceGroupGetDataSource(workspaceGroupName str
 	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspacegroups.DataSourceGetName), workspaceGroupName})
 }
 
+func (uc UpdatableConfig) WithWorkspaceGroupExportDataSource(workspaceGroupName string) AttributeSetter {
+	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspacegroups.DataSourceExportName), workspaceGroupName})
+}
+
 func (uc UpdatableConfig) WithWorkspaceGetDataSource(workspaceName string) AttributeSetter {
 	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspaces.DataSourceGetName), workspaceName})
 }
 
+func (uc UpdatableConfig) WithWorkspaceConnectionDataSource(workspaceName string) AttributeSetter {
+	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspaces.DataSourceConnectionName), workspaceName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceHealthDataSource(workspaceName string) AttributeSetter {
+	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspaces.DataSourceHealthName), workspaceName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceCertificateDataSource(workspaceName string) AttributeSetter {
+	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspaces.DataSourceCertificateName), workspaceName})
+}
+
 func (uc UpdatableConfig) WithWorkspaceListDataSource(workspaceListName string) AttributeSetter {
 	return withAttribute(uc, config.DataSourceTypeName, []string{dataSourceTypeName(workspaces.DataSourceListName), workspaceListName})
 }
@@ -35,10 +54,54 @@ func (uc UpdatableConfig) WithWorkspaceResource(workspaceName string) AttributeS
 	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspaces.ResourceName), workspaceName})
 }
 
+func (uc UpdatableConfig) WithWorkspaceFleetResource(workspaceFleetName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspaces.ResourceFleetName), workspaceFleetName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupWorkspacesResource(workspaceGroupWorkspacesName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspaces.ResourceGroupWorkspacesName), workspaceGroupWorkspacesName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupCloneResource(workspaceGroupCloneName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceCloneName), workspaceGroupCloneName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupSetResource(workspaceGroupSetName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceSetName), workspaceGroupSetName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupPauseResource(workspaceGroupPauseName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspaces.ResourcePauseName), workspaceGroupPauseName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupUpdateWindowResource(workspaceGroupUpdateWindowName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceUpdateWindowName), workspaceGroupUpdateWindowName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupFirewallResource(workspaceGroupFirewallName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceFirewallName), workspaceGroupFirewallName})
+}
+
+func (uc UpdatableConfig) WithWorkspaceGroupFirewallRuleResource(workspaceGroupFirewallRuleName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceFirewallRuleName), workspaceGroupFirewallRuleName})
+}
+
 func (uc UpdatableConfig) WithWorkspaceGroupResource(workspaceGroupName string) AttributeSetter {
 	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(workspacegroups.ResourceName), workspaceGroupName})
 }
 
+func (uc UpdatableConfig) WithSeedResource(seedName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(seeds.ResourceName), seedName})
+}
+
+func (uc UpdatableConfig) WithSQLScriptResource(sqlScriptName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(sqlscripts.ResourceName), sqlScriptName})
+}
+
+func (uc UpdatableConfig) WithPrivateConnectionResource(privateConnectionName string) AttributeSetter {
+	return withAttribute(uc, config.ResourceTypeName, []string{resourceTypeName(privateconnections.ResourceName), privateConnectionName})
+}
+
 // WithAPIKey extends the config with the API key if the key is not empty.
 func (uc UpdatableConfig) WithAPIKey(apiKey string) UpdatableConfig {
 	if apiKey == "" {
@@ -68,6 +131,26 @@ func (uc UpdatableConfig) WithAPIServiceURL(url string) UpdatableConfig {
 	)
 }
 
+// WithReadOnly extends the config with the read-only mode of the provider.
+func (uc UpdatableConfig) WithReadOnly(readOnly bool) UpdatableConfig {
+	return withAttribute(uc, config.ProviderTypeName, []string{config.ProviderName})(
+		config.ReadOnlyAttribute, cty.BoolVal(readOnly),
+	)
+}
+
+func (uc UpdatableConfig) WithStrictScopes(strictScopes bool) UpdatableConfig {
+	return withAttribute(uc, config.ProviderTypeName, []string{config.ProviderName})(
+		config.StrictScopesAttribute, cty.BoolVal(strictScopes),
+	)
+}
+
+// WithRegionsCacheTTL extends the config with the regions cache TTL of the provider.
+func (uc UpdatableConfig) WithRegionsCacheTTL(ttl string) UpdatableConfig {
+	return withAttribute(uc, config.ProviderTypeName, []string{config.ProviderName})(
+		config.RegionsCacheTTLAttribute, cty.StringVal(ttl),
+	)
+}
+
 // String shows the resulting *.tf config with all the overrides applied.
 func (uc UpdatableConfig) String() string {
 	return string(uc)
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &cidrValidator{}
+
+// cidrValidator validates that a string Attribute's value is a CIDR range.
+type cidrValidator struct {
+	message string
+}
+
+// Description describes the validation in plain text formatting.
+func (v cidrValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return "value must be a CIDR range, e.g., \"10.0.0.0/8\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v *cidrValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		v.message = err.Error()
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// NewCIDRValidator returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Is a CIDR range, e.g., "10.0.0.0/8".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func NewCIDRValidator() validator.String {
+	return &cidrValidator{}
+}
@@ -0,0 +1,40 @@
+package util_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCIDRValidator(t *testing.T) {
+	ctx := context.Background()
+
+	v := util.NewCIDRValidator()
+	defaultMessage := v.Description(ctx)
+	require.NotEmpty(t, defaultMessage)
+	require.NotEmpty(t, v.MarkdownDescription(ctx))
+
+	v = util.NewCIDRValidator()
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{}, resp)
+	require.Empty(t, resp.Diagnostics, "not set string is fine")
+
+	for _, invalid := range []string{"10.0.0.1", "10.0.0.0/33", "localhost"} {
+		v = util.NewCIDRValidator()
+		resp = &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(invalid)}, resp)
+		require.NotEmpty(t, resp.Diagnostics, invalid)
+		require.NotEqual(t, defaultMessage, v.Description(ctx), "shows the error")
+	}
+
+	for _, valid := range []string{"10.0.0.0/8", "127.0.0.1/32", "0.0.0.0/0", "2001:db8::/32"} {
+		v = util.NewCIDRValidator()
+		resp = &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(valid)}, resp)
+		require.Empty(t, resp.Diagnostics, valid)
+	}
+}
@@ -0,0 +1,110 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitOpenError indicates a call that is not issued because the previous calls failed consecutively.
+type CircuitOpenError struct {
+	Failures int
+	Last     error
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("not calling the SingleStore API after %d consecutive failed calls, the last one failed with: %s", e.Failures, e.Last)
+}
+
+type circuitBreaker struct {
+	next      http.RoundTripper
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	last     error
+	openedAt time.Time
+}
+
+var _ http.RoundTripper = &circuitBreaker{}
+
+// NewCircuitBreaker wraps the round tripper to fail fast after the threshold of the consecutive failed calls.
+//
+// A call fails if the round tripper returns an error, e.g., after the retries are exhausted.
+// Once the cooldown passes, a single call is attempted again, closing the circuit on success.
+func NewCircuitBreaker(next http.RoundTripper, threshold int, cooldown time.Duration) http.RoundTripper {
+	return &circuitBreaker{
+		next:      next,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (cb *circuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := cb.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := cb.next.RoundTrip(req)
+	cb.record(req.Context(), err)
+
+	return resp, err
+}
+
+func (cb *circuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return nil
+	}
+
+	if time.Since(cb.openedAt) >= cb.cooldown {
+		cb.openedAt = time.Now() // Letting a single call through, the others keep failing fast until it completes.
+
+		return nil
+	}
+
+	return &CircuitOpenError{Failures: cb.failures, Last: cb.last}
+}
+
+func (cb *circuitBreaker) record(ctx context.Context, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil {
+		cb.failures = 0
+		cb.last = nil
+
+		return
+	}
+
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return // Canceled by Terraform rather than failed by the API.
+	}
+
+	cb.failures++
+	cb.last = err
+	if cb.failures >= cb.threshold {
+		cb.openedAt = time.Now()
+	}
+}
+
+// APIUnreachableError reports the calls that failed fast.
+//
+// The detail does not depend on the call, so that the pending operations of all the resources report the same diagnostic.
+func APIUnreachableError(err *CircuitOpenError) *SummaryWithDetailError {
+	return &SummaryWithDetailError{
+		Summary: "SingleStore API is unreachable",
+		Detail: fmt.Sprintf("The last %d calls to the SingleStore API failed consecutively even after retries, ", err.Failures) +
+			"so the pending operations fail fast rather than time out one by one. " +
+			"Check the network connectivity to the API and the status of the SingleStore service, then apply again. " +
+			"Resources that were already created are kept in the state." +
+			"\n\nLast error: " + Redact(err.Last.Error()),
+	}
+}
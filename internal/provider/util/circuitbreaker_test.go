@@ -0,0 +1,117 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	networkErr := errors.New("connection refused")
+	calls := 0
+	cb := util.NewCircuitBreaker(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+
+		return nil, networkErr
+	}), 3, time.Hour)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := cb.RoundTrip(req) //nolint: bodyclose
+		require.ErrorIs(t, err, networkErr)
+	}
+
+	_, err = cb.RoundTrip(req) //nolint: bodyclose
+	var cerr *util.CircuitOpenError
+	require.ErrorAs(t, err, &cerr, "fails fast once open")
+	require.Equal(t, 3, cerr.Failures)
+	require.ErrorContains(t, err, networkErr.Error())
+	require.Equal(t, 3, calls, "should not call the API once open")
+
+	serr := util.StatusOK(nil, err)
+	require.NotNil(t, serr)
+	require.Equal(t, "SingleStore API is unreachable", serr.Summary)
+	require.Contains(t, serr.Detail, networkErr.Error())
+}
+
+func TestCircuitBreakerClosesAfterCooldown(t *testing.T) {
+	fail := true
+	cb := util.NewCircuitBreaker(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), 2, 0)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := cb.RoundTrip(req) //nolint: bodyclose
+		require.Error(t, err)
+	}
+
+	fail = false
+	resp, err := cb.RoundTrip(req) //nolint: bodyclose
+	require.NoError(t, err, "attempts a call after the cooldown")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestCircuitBreakerCountsConsecutiveFailures(t *testing.T) {
+	responses := []error{errors.New("timeout"), nil, errors.New("timeout"), nil}
+	cb := util.NewCircuitBreaker(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		err := responses[0]
+		responses = responses[1:]
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{StatusCode: http.StatusConflict}, nil // A response, even unsuccessful, resets the count.
+	}), 2, time.Hour)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, _ = cb.RoundTrip(req) //nolint: bodyclose
+	}
+
+	resp, err := cb.RoundTrip(req) //nolint: bodyclose
+	require.NoError(t, err, "the failures are not consecutive")
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestCircuitBreakerIgnoresCancellation(t *testing.T) {
+	calls := 0
+	cb := util.NewCircuitBreaker(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+
+		return nil, context.Canceled
+	}), 1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = cb.RoundTrip(req) //nolint: bodyclose
+		require.ErrorIs(t, err, context.Canceled)
+	}
+
+	require.Equal(t, 2, calls, "cancellation should not open the circuit")
+}
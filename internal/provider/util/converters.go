@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	otypes "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/singlestore-labs/singlestore-go/management"
 )
@@ -40,6 +41,18 @@ func UUIDStringValue(id otypes.UUID) types.String {
 	return types.StringValue(id.String())
 }
 
+func MaybeUUID(s types.String) *otypes.UUID {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+
+	return Ptr(uuid.MustParse(s.ValueString()))
+}
+
+func MaybeUUIDStringValue(id *otypes.UUID) types.String {
+	return maybeElse(id, UUIDStringValue, types.StringNull)
+}
+
 func StringFirewallRanges(frs []types.String) []string {
 	return Map(frs, ToString)
 }
@@ -30,6 +30,19 @@ func TestMaybeStringValue(t *testing.T) {
 	require.Equal(t, types.StringValue(s), util.MaybeStringValue(&s))
 }
 
+func TestMaybeUUID(t *testing.T) {
+	require.Nil(t, util.MaybeUUID(types.StringNull()))
+	require.Nil(t, util.MaybeUUID(types.StringUnknown()))
+	id := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	require.Equal(t, &id, util.MaybeUUID(types.StringValue(id.String())))
+}
+
+func TestMaybeUUIDStringValue(t *testing.T) {
+	require.Equal(t, types.StringNull(), util.MaybeUUIDStringValue(nil))
+	id := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	require.Equal(t, types.StringValue(id.String()), util.MaybeUUIDStringValue(&id))
+}
+
 func TestMaybeBool(t *testing.T) {
 	require.Nil(t, util.MaybeBool(types.BoolNull()))
 	require.Nil(t, util.MaybeBool(types.BoolUnknown()))
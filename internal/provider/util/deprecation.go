@@ -0,0 +1,154 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+type deprecationNoticesKey struct{}
+
+type deprecationNotices struct {
+	mu      sync.Mutex
+	notices []string
+}
+
+// CollectDeprecationNotices returns a context that collects the deprecation notices
+// of the API calls issued with it and a function that adds them as warnings.
+//
+// A notice is added once per operation however many times the API returns it, e.g., while polling.
+func CollectDeprecationNotices(ctx context.Context) (context.Context, func(*diag.Diagnostics)) {
+	dn := &deprecationNotices{}
+
+	return context.WithValue(ctx, deprecationNoticesKey{}, dn), dn.warn
+}
+
+func (dn *deprecationNotices) add(notice string) {
+	dn.mu.Lock()
+	defer dn.mu.Unlock()
+
+	if !Any(dn.notices, notice) {
+		dn.notices = append(dn.notices, notice)
+	}
+}
+
+func (dn *deprecationNotices) warn(diags *diag.Diagnostics) {
+	dn.mu.Lock()
+	defer dn.mu.Unlock()
+
+	for _, notice := range dn.notices {
+		diags.AddWarning("SingleStore API deprecation", notice)
+	}
+}
+
+type deprecationNotifier struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = deprecationNotifier{}
+
+// NewDeprecationNotifier wraps the round tripper to collect the notices of the Deprecation (RFC 9745)
+// and Sunset (RFC 8594) response headers into the context of the request, see CollectDeprecationNotices.
+func NewDeprecationNotifier(next http.RoundTripper) http.RoundTripper {
+	return deprecationNotifier{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (dn deprecationNotifier) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := dn.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	notices, ok := req.Context().Value(deprecationNoticesKey{}).(*deprecationNotices)
+	if !ok {
+		return resp, err
+	}
+
+	if notice, ok := deprecationNotice(req.Method, req.URL.Path, resp.Header); ok {
+		notices.add(notice)
+	}
+
+	return resp, err
+}
+
+func deprecationNotice(method, path string, header http.Header) (string, bool) {
+	deprecation := header.Get("Deprecation")
+	sunset := header.Get("Sunset")
+	if deprecation == "" && sunset == "" {
+		return "", false
+	}
+
+	result := fmt.Sprintf("The SingleStore API call %s %s is deprecated", method, path)
+	if since, ok := deprecationDate(deprecation); ok {
+		result += " since " + since
+	}
+
+	result += "."
+
+	if sunset != "" {
+		result += " It will stop working after " + httpDate(sunset) + "."
+	}
+
+	if link := linkWithRelation(header.Values("Link"), "deprecation", "sunset"); link != "" {
+		result += " See " + link + " for the details."
+	}
+
+	return result + fmt.Sprintf(" Upgrade the provider to the latest version, and if the warning persists, please report the issue %s.", config.SupportURL), true
+}
+
+// deprecationDate returns the date of the Deprecation header, which is either a structured field date, e.g., @1688169599,
+// or an HTTP date or true in the earlier drafts.
+func deprecationDate(value string) (string, bool) {
+	if seconds, ok := strings.CutPrefix(value, "@"); ok {
+		unix, err := strconv.ParseInt(seconds, 10, 64)
+		if err != nil {
+			return "", false
+		}
+
+		return time.Unix(unix, 0).UTC().Format(time.DateOnly), true
+	}
+
+	if _, err := http.ParseTime(value); err != nil {
+		return "", false
+	}
+
+	return httpDate(value), true
+}
+
+func httpDate(value string) string {
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return value
+	}
+
+	return t.UTC().Format(time.DateOnly)
+}
+
+// linkWithRelation returns the first target of the Link header values with any of the relations.
+func linkWithRelation(values []string, relations ...string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			target, params, ok := strings.Cut(link, ";")
+			if !ok {
+				continue
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				name, rel, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(name, "rel") && Any(relations, strings.Trim(rel, `"`)) {
+					return strings.Trim(strings.TrimSpace(target), "<>")
+				}
+			}
+		}
+	}
+
+	return ""
+}
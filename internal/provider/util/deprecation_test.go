@@ -0,0 +1,57 @@
+package util_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecationNotifier(t *testing.T) {
+	header := http.Header{}
+	header.Set("Deprecation", "@1688169599")
+	header.Set("Sunset", "Sun, 30 Jun 2024 23:59:59 GMT")
+	header.Add("Link", `<https://docs.singlestore.com/regions>; rel="deprecation"; type="text/html"`)
+
+	rt := util.NewDeprecationNotifier(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/v1/regions" {
+			return &http.Response{StatusCode: http.StatusOK, Header: header}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil
+	}))
+
+	ctx, warnDeprecations := util.CollectDeprecationNotices(context.Background())
+	for _, path := range []string{"/v1/regions", "/v1/workspaces", "/v1/regions"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.singlestore.com"+path, nil)
+		require.NoError(t, err)
+		_, err = rt.RoundTrip(req)
+		require.NoError(t, err)
+	}
+
+	var diags diag.Diagnostics
+	warnDeprecations(&diags)
+	require.Len(t, diags, 1, "the same notice should be added once per operation")
+	require.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	require.Contains(t, diags[0].Detail(), "GET /v1/regions is deprecated since 2023-06-30.")
+	require.Contains(t, diags[0].Detail(), "It will stop working after 2024-06-30.")
+	require.Contains(t, diags[0].Detail(), "See https://docs.singlestore.com/regions for the details.")
+}
+
+func TestDeprecationNotifierWithoutCollector(t *testing.T) {
+	header := http.Header{}
+	header.Set("Deprecation", "true")
+
+	rt := util.NewDeprecationNotifier(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: header}, nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.singlestore.com/v1/regions", nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "the response should be returned as is")
+}
@@ -0,0 +1,124 @@
+package util
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResourceWithDeprecationWarnings wraps the resource so that its operations add the deprecation notices
+// of the API calls they issue as warnings, see CollectDeprecationNotices.
+func ResourceWithDeprecationWarnings(newResource func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		r := deprecationWarningsResource{Resource: newResource()}
+		if _, ok := r.Resource.(resource.ResourceWithImportState); ok {
+			return importableDeprecationWarningsResource{r}
+		}
+
+		return r
+	}
+}
+
+// DataSourceWithDeprecationWarnings wraps the data source so that reading it adds the deprecation notices
+// of the API calls it issues as warnings, see CollectDeprecationNotices.
+func DataSourceWithDeprecationWarnings(newDataSource func() datasource.DataSource) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return deprecationWarningsDataSource{DataSource: newDataSource()}
+	}
+}
+
+type deprecationWarningsResource struct {
+	resource.Resource
+}
+
+var (
+	_ resource.ResourceWithConfigure  = deprecationWarningsResource{}
+	_ resource.ResourceWithModifyPlan = deprecationWarningsResource{}
+)
+
+// Configure configures the wrapped resource if it needs the provider data.
+func (r deprecationWarningsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if rwc, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		rwc.Configure(ctx, req, resp)
+	}
+}
+
+// Create creates the resource and warns of the deprecated API calls.
+func (r deprecationWarningsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	r.Resource.Create(ctx, req, resp)
+}
+
+// Read refreshes the resource and warns of the deprecated API calls.
+func (r deprecationWarningsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	r.Resource.Read(ctx, req, resp)
+}
+
+// Update updates the resource and warns of the deprecated API calls.
+func (r deprecationWarningsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	r.Resource.Update(ctx, req, resp)
+}
+
+// Delete deletes the resource and warns of the deprecated API calls.
+func (r deprecationWarningsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	r.Resource.Delete(ctx, req, resp)
+}
+
+// ModifyPlan modifies the plan if the wrapped resource does and warns of the deprecated API calls.
+func (r deprecationWarningsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	rwmp, ok := r.Resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	rwmp.ModifyPlan(ctx, req, resp)
+}
+
+// importableDeprecationWarningsResource is kept apart, so that the resources that do not support importing
+// keep failing the import as the framework does.
+type importableDeprecationWarningsResource struct {
+	deprecationWarningsResource
+}
+
+var _ resource.ResourceWithImportState = importableDeprecationWarningsResource{}
+
+// ImportState imports the resource.
+func (r importableDeprecationWarningsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.Resource.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+}
+
+type deprecationWarningsDataSource struct {
+	datasource.DataSource
+}
+
+var _ datasource.DataSourceWithConfigure = deprecationWarningsDataSource{}
+
+// Configure configures the wrapped data source if it needs the provider data.
+func (d deprecationWarningsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if dswc, ok := d.DataSource.(datasource.DataSourceWithConfigure); ok {
+		dswc.Configure(ctx, req, resp)
+	}
+}
+
+// Read reads the data source and warns of the deprecated API calls.
+func (d deprecationWarningsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnDeprecations := CollectDeprecationNotices(ctx)
+	defer warnDeprecations(&resp.Diagnostics)
+
+	d.DataSource.Read(ctx, req, resp)
+}
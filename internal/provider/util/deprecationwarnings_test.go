@@ -0,0 +1,75 @@
+package util_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceWithDeprecationWarnings(t *testing.T) {
+	rt := util.NewDeprecationNotifier(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("Deprecation", "true")
+
+		return &http.Response{StatusCode: http.StatusOK, Header: header}, nil
+	}))
+
+	newResource := func() resource.Resource {
+		return &deprecatedCallResource{t: t, rt: rt}
+	}
+
+	r := util.ResourceWithDeprecationWarnings(newResource)()
+	_, ok := r.(resource.ResourceWithImportState)
+	require.False(t, ok, "should not make the resource importable")
+
+	resp := resource.ReadResponse{}
+	r.Read(context.Background(), resource.ReadRequest{}, &resp)
+	require.Len(t, resp.Diagnostics, 1)
+	require.Equal(t, diag.SeverityWarning, resp.Diagnostics[0].Severity())
+	require.Contains(t, resp.Diagnostics[0].Detail(), "GET /v1/regions is deprecated.")
+
+	importable := util.ResourceWithDeprecationWarnings(func() resource.Resource {
+		return &importableDeprecatedCallResource{deprecatedCallResource{t: t, rt: rt}}
+	})()
+	_, ok = importable.(resource.ResourceWithImportState)
+	require.True(t, ok, "should keep the resource importable")
+}
+
+type deprecatedCallResource struct {
+	t  *testing.T
+	rt http.RoundTripper
+}
+
+func (r *deprecatedCallResource) Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse) {
+}
+
+func (r *deprecatedCallResource) Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse) {
+}
+
+func (r *deprecatedCallResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *deprecatedCallResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.singlestore.com/v1/regions", nil)
+	require.NoError(r.t, err)
+	_, err = r.rt.RoundTrip(req)
+	require.NoError(r.t, err)
+}
+
+func (r *deprecatedCallResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *deprecatedCallResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+type importableDeprecatedCallResource struct {
+	deprecatedCallResource
+}
+
+func (r *importableDeprecatedCallResource) ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse) {
+}
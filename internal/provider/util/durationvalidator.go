@@ -0,0 +1,70 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &durationValidator{}
+
+// durationValidator validates that a string Attribute's value is a positive duration.
+type durationValidator struct {
+	message string
+}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return "value must be a positive duration string"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v *durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if _, err := ParseDuration(value); err != nil {
+		v.message = err.Error()
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// NewDurationValidator returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Is a Go duration, e.g., "90m" or "2h30m".
+//   - Is positive.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func NewDurationValidator() validator.String {
+	return &durationValidator{}
+}
+
+// ParseDuration parses a positive duration.
+func ParseDuration(durationString string) (time.Duration, error) {
+	d, err := time.ParseDuration(durationString)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("should be a positive duration, e.g., %q", "2h30m")
+	}
+
+	return d, nil
+}
@@ -0,0 +1,47 @@
+package util_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationValidator(t *testing.T) {
+	ctx := context.Background()
+
+	v := util.NewDurationValidator()
+	defaultMessage := v.Description(ctx)
+	require.NotEmpty(t, defaultMessage)
+	require.NotEmpty(t, v.MarkdownDescription(ctx))
+
+	v = util.NewDurationValidator()
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{}, resp)
+	require.Empty(t, resp.Diagnostics, "not set string is fine")
+
+	v = util.NewDurationValidator()
+	resp = &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue("a day")}, resp)
+	require.NotEmpty(t, resp.Diagnostics)
+	require.NotEqual(t, defaultMessage, v.Description(ctx), "shows the error")
+
+	v = util.NewDurationValidator()
+	resp = &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue("-1h")}, resp)
+	require.NotEmpty(t, resp.Diagnostics, "requires positive")
+
+	v = util.NewDurationValidator()
+	resp = &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue("2h30m")}, resp)
+	require.Empty(t, resp.Diagnostics)
+	require.Equal(t, defaultMessage, v.Description(ctx))
+
+	d, err := util.ParseDuration("90m")
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, d)
+}
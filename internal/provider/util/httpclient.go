@@ -7,16 +7,23 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
 )
 
 const respReadLimit = int64(4096)
 
 // NewHTTPClient creates an HTTP client for the Terraform provider.
+//
+// The calls that fail after the retries are counted by a circuit breaker,
+// so that the calls of all the resources fail fast once the API is unreachable.
 func NewHTTPClient() *http.Client {
-	result := retryablehttp.NewClient()
-	result.ErrorHandler = HandleError
+	retryable := retryablehttp.NewClient()
+	retryable.ErrorHandler = HandleError
+
+	result := retryable.StandardClient()
+	result.Transport = NewDeprecationNotifier(NewCircuitBreaker(result.Transport, config.CircuitBreakerThreshold, config.CircuitBreakerCooldown))
 
-	return result.StandardClient()
+	return result
 }
 
 var _ retryablehttp.ErrorHandler = HandleError
@@ -40,7 +47,7 @@ func HandleError(resp *http.Response, ierr error, numTries int) (*http.Response,
 		return nil, maybeWithExtraError(result, ierr)
 	}
 
-	result := fmt.Sprintf("giving up after %d attempts, unexpected status code: %s, response: %s", numTries, http.StatusText(resp.StatusCode), body)
+	result := fmt.Sprintf("giving up after %d attempts, unexpected status code: %s, response: %s", numTries, http.StatusText(resp.StatusCode), Redact(string(body)))
 
 	return nil, maybeWithExtraError(result, ierr)
 }
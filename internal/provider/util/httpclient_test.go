@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"testing/iotest"
 
@@ -74,6 +75,15 @@ func TestHTTPClientStatusConflict(t *testing.T) {
 	require.Equal(t, body, result)
 }
 
+func TestHandleErrorRedactsBody(t *testing.T) {
+	_, err := util.HandleError(&http.Response{ //nolint: bodyclose
+		StatusCode: http.StatusInternalServerError,
+		Body:       io.NopCloser(strings.NewReader(`{"adminPassword":"fooBAR12$"}`)),
+	}, nil, 1)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "fooBAR12$")
+}
+
 func TestHandleError(t *testing.T) {
 	readErr := errors.New("failed to read")
 	extra := errors.New("extra")
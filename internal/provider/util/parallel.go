@@ -0,0 +1,31 @@
+package util
+
+import "sync"
+
+// InParallel calls f for each index from 0 to n-1 with at most limit calls at a time and returns the first error by index.
+func InParallel(n, limit int, f func(i int) *SummaryWithDetailError) *SummaryWithDetailError {
+	errs := make([]*SummaryWithDetailError, n)
+	semaphore := make(chan struct{}, limit)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			errs[i] = f(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, serr := range errs {
+		if serr != nil {
+			return serr
+		}
+	}
+
+	return nil
+}
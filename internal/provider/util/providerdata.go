@@ -0,0 +1,22 @@
+package util
+
+import (
+	"github.com/singlestore-labs/singlestore-go/management"
+)
+
+// ProviderData is what the provider passes to the Configure methods of the resources and data sources:
+// the Management API client along with the provider settings that change how they use it.
+//
+// The resources and data sources embed it, so that they call the Management API directly.
+type ProviderData struct {
+	management.ClientWithResponsesInterface
+
+	// ReadOnly makes the code writing through the other APIs, e.g., the Data API, refuse to do so.
+	ReadOnly bool
+	// StrictScopes fails the auxiliary reads for which the API key lacks the scopes instead of degrading them to warnings.
+	StrictScopes bool
+	// StateEncryptionPassphrase keeps the generated secrets in the state only encrypted unless it is empty.
+	StateEncryptionPassphrase string
+	// RegionsCache keeps the listed regions between runs if the provider configures it.
+	RegionsCache RegionsCache
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// ErrReadOnly indicates a write call that the read-only mode of the provider refused to issue.
+var ErrReadOnly = fmt.Errorf("the provider is configured with %s = true and never issues write calls", config.ReadOnlyAttribute)
+
+// RejectWrites is a request editor that fails all the requests except for GET and HEAD.
+//
+// It is a hard guard: the request is not sent, whatever resource or data source issues it.
+func RejectWrites(_ context.Context, req *http.Request) error {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return nil
+	}
+
+	return fmt.Errorf("refused to call %s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+}
+
+// ReadOnlyError reports the refusal to write in the read-only mode of the provider.
+func ReadOnlyError(cause string) *SummaryWithDetailError {
+	return &SummaryWithDetailError{
+		Summary: "SingleStore provider is read-only",
+		Detail: fmt.Sprintf("The provider is configured with %s = true, e.g., for drift detection with read-only credentials. ", config.ReadOnlyAttribute) +
+			"Refreshing and planning work as usual, yet applying changes is not possible. " +
+			fmt.Sprintf("Remove the %s attribute of the provider to apply the changes.", config.ReadOnlyAttribute) +
+			"\n\nCause: " + cause,
+	}
+}
@@ -0,0 +1,39 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectWrites(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		req, err := http.NewRequestWithContext(context.Background(), method, "https://example.com/v1/workspaces", nil)
+		require.NoError(t, err)
+		require.NoError(t, util.RejectWrites(context.Background(), req), method)
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequestWithContext(context.Background(), method, "https://example.com/v1/workspaces", nil)
+		require.NoError(t, err)
+		err = util.RejectWrites(context.Background(), req)
+		require.ErrorIs(t, err, util.ErrReadOnly, method)
+		require.Contains(t, err.Error(), "/v1/workspaces")
+
+		result := util.StatusOK(nil, err)
+		require.NotNil(t, result)
+		require.Contains(t, result.Summary, "read-only")
+	}
+}
+
+func TestReadOnlyError(t *testing.T) {
+	require.False(t, errors.Is(errors.New("foo"), util.ErrReadOnly))
+
+	serr := util.ReadOnlyError("refused to run SQL statements")
+	require.Contains(t, serr.Summary, "read-only")
+	require.Contains(t, serr.Detail, "refused to run SQL statements")
+}
@@ -0,0 +1,94 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// RegionsCache keeps the listed regions in a file in the temporary directory,
+// so that the following runs within the TTL do not list them again.
+//
+// The zero value caches nothing.
+type RegionsCache struct {
+	path string
+	ttl  time.Duration
+}
+
+// NewRegionsCache caches the regions for the TTL in a file unique to the API service URL and the API key,
+// since the regions that an organization may use vary.
+func NewRegionsCache(apiServiceURL, apiKey string, ttl time.Duration) RegionsCache {
+	sum := sha256.Sum256([]byte(apiServiceURL + "\n" + apiKey))
+	name := "regions-" + hex.EncodeToString(sum[:]) + ".json"
+
+	return RegionsCache{
+		path: filepath.Join(os.TempDir(), config.RegionsCacheDirName, name),
+		ttl:  ttl,
+	}
+}
+
+// Load returns the regions that were cached within the TTL if any.
+func (rc RegionsCache) Load() ([]management.Region, bool) {
+	if rc.path == "" {
+		return nil, false
+	}
+
+	info, err := os.Stat(rc.path)
+	if err != nil || time.Since(info.ModTime()) > rc.ttl {
+		return nil, false
+	}
+
+	body, err := os.ReadFile(rc.path)
+	if err != nil {
+		return nil, false
+	}
+
+	var result []management.Region
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, false // Listing the regions again overwrites the corrupted file.
+	}
+
+	return result, true
+}
+
+// Store stores the listed regions unless the cache is the zero value.
+//
+// Failing to store is ignored since the cache only saves the calls.
+func (rc RegionsCache) Store(regions []management.Region) {
+	if rc.path == "" {
+		return
+	}
+
+	body, err := json.Marshal(regions)
+	if err != nil {
+		return
+	}
+
+	dir := filepath.Dir(rc.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(rc.path)+".*")
+	if err != nil {
+		return
+	}
+
+	_, werr := f.Write(body)
+	cerr := f.Close()
+	if werr != nil || cerr != nil {
+		_ = os.Remove(f.Name())
+
+		return
+	}
+
+	if err := os.Rename(f.Name(), rc.path); err != nil { // Concurrent runs never read a partially written file.
+		_ = os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,43 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionsCache(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("e495c7f3-b37a-4234-8e8f-f715257e3a6c"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	var disabled util.RegionsCache
+	disabled.Store(regions)
+	_, ok := disabled.Load()
+	require.False(t, ok, "should not cache without the provider configuring the cache")
+
+	cache := util.NewRegionsCache("https://api.example.com", "foo", time.Hour)
+	_, ok = cache.Load()
+	require.False(t, ok, "should be empty before the regions are listed")
+
+	cache.Store(regions)
+	result, ok := cache.Load()
+	require.True(t, ok)
+	require.Equal(t, regions, result)
+
+	_, ok = util.NewRegionsCache("https://api.example.com", "bar", time.Hour).Load()
+	require.False(t, ok, "should keep the regions of every API key apart")
+
+	_, ok = util.NewRegionsCache("https://api.example.com", "foo", 0).Load()
+	require.False(t, ok, "should expire the cached regions after the TTL")
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// TolerateInsufficientScope makes StatusOK succeed on the insufficient scope of the API key, i.e., 403,
+// unless the provider has strict scopes; check the status code with IsInsufficientScope afterwards.
+//
+// Use it only for auxiliary reads, the result of which the configuration can do without.
+func TolerateInsufficientScope(strictScopes bool) StatusOKOption {
+	return func(code int) (bool, *SummaryWithDetailError) {
+		return code == http.StatusForbidden && !strictScopes, nil
+	}
+}
+
+// IsInsufficientScope reports whether the API rejected the call for the insufficient scope of the API key.
+func IsInsufficientScope(resp StatusCoder) bool {
+	return resp.StatusCode() == http.StatusForbidden
+}
+
+// InsufficientScopeWarning reports the auxiliary data that is missing for the insufficient scope of the API key.
+func InsufficientScopeWarning(what string) *SummaryWithDetailError {
+	return &SummaryWithDetailError{
+		Summary: "SingleStore API key lacks a scope",
+		Detail: fmt.Sprintf("The SingleStore API refused to provide %s for the insufficient scope of the API key, so the data is incomplete and insufficient_scope is true. ", what) +
+			"Grant the API key the scope in the SingleStore Portal to get the complete data. " +
+			fmt.Sprintf("Set %s = true in the provider configuration to fail instead.", config.StrictScopesAttribute),
+	}
+}
@@ -0,0 +1,21 @@
+package util_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTolerateInsufficientScope(t *testing.T) {
+	skip, serr := util.TolerateInsufficientScope(false)(http.StatusForbidden)
+	require.True(t, skip, "should tolerate the insufficient scope by default")
+	require.Nil(t, serr)
+
+	skip, _ = util.TolerateInsufficientScope(false)(http.StatusNotFound)
+	require.False(t, skip, "should tolerate the insufficient scope only")
+
+	skip, _ = util.TolerateInsufficientScope(true)(http.StatusForbidden)
+	require.False(t, skip, "should fail with strict scopes")
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	stateEncryptionSaltSize = 16
+	stateEncryptionKeySize  = 32 // AES-256.
+)
+
+// EncryptState encrypts the value with AES-256-GCM using a key derived from the passphrase with scrypt (N=32768, r=8, p=1).
+//
+// The result is the base64 encoding of the 16 bytes of the salt, the 12 bytes of the nonce, and the sealed value.
+func EncryptState(passphrase, value string) (string, error) {
+	salt := make([]byte, stateEncryptionSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", fmt.Errorf("failed to generate a salt: %w", err)
+	}
+
+	aead, err := stateEncryptionAEAD(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate a nonce: %w", err)
+	}
+
+	result := append(append(salt, nonce...), aead.Seal(nil, nonce, []byte(value), nil)...)
+
+	return base64.StdEncoding.EncodeToString(result), nil
+}
+
+// DecryptState reverts EncryptState.
+func DecryptState(passphrase, encrypted string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the encrypted value: %w", err)
+	}
+
+	if len(data) < stateEncryptionSaltSize {
+		return "", errors.New("the encrypted value is too short")
+	}
+
+	aead, err := stateEncryptionAEAD(passphrase, data[:stateEncryptionSaltSize])
+	if err != nil {
+		return "", err
+	}
+
+	data = data[stateEncryptionSaltSize:]
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("the encrypted value is too short")
+	}
+
+	result, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt the value, the passphrase might be wrong: %w", err)
+	}
+
+	return string(result), nil
+}
+
+func stateEncryptionAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, stateEncryptionKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the state encryption key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the state encryption cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,28 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptState(t *testing.T) {
+	encrypted, err := util.EncryptState("passphrase", "secret")
+	require.NoError(t, err)
+	require.NotContains(t, encrypted, "secret")
+
+	again, err := util.EncryptState("passphrase", "secret")
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, again, "should use a random salt and nonce")
+
+	decrypted, err := util.DecryptState("passphrase", encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "secret", decrypted)
+
+	_, err = util.DecryptState("wrong", encrypted)
+	require.Error(t, err)
+
+	_, err = util.DecryptState("passphrase", "c2hvcnQ=")
+	require.Error(t, err)
+}
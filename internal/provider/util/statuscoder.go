@@ -1,6 +1,7 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -17,12 +18,21 @@ type StatusOKOption func(code int) (overrideReturn bool, newResult *SummaryWithD
 func StatusOK(resp StatusCoder, ierr error,
 	opts ...StatusOKOption,
 ) *SummaryWithDetailError {
+	if errors.Is(ierr, ErrReadOnly) {
+		return ReadOnlyError(ierr.Error())
+	}
+
+	var cerr *CircuitOpenError
+	if errors.As(ierr, &cerr) {
+		return APIUnreachableError(cerr)
+	}
+
 	if ierr != nil {
 		return &SummaryWithDetailError{
 			Summary: "SingleStore API client call failed",
 			Detail: "An unexpected error occurred when calling SingleStore API. " +
 				config.CreateProviderIssueIfNotClearErrorDetail +
-				"\n\nSingleStore client error: " + ierr.Error(),
+				"\n\nSingleStore client error: " + Redact(ierr.Error()),
 		}
 	}
 
@@ -35,6 +45,17 @@ func StatusOK(resp StatusCoder, ierr error,
 		}
 	}
 
+	if code == http.StatusUnauthorized {
+		return &SummaryWithDetailError{
+			Summary: "SingleStore API key is not valid",
+			Detail: fmt.Sprintf("The SingleStore API rejected the API key with status code %s. ", http.StatusText(code)) +
+				"The key might have expired or been revoked, " +
+				"including in the middle of a long apply. Resources that were already created are kept in the state. " +
+				config.InvalidAPIKeyErrorDetail +
+				"\n\nSingleStore client response body: " + MaybeBody(resp),
+		}
+	}
+
 	if code != http.StatusOK {
 		return &SummaryWithDetailError{
 			Summary: fmt.Sprintf("SingleStore API client returned status code %s", http.StatusText(code)),
@@ -56,6 +77,7 @@ func ReturnNilOnNotFound(code int) (bool, *SummaryWithDetailError) {
 	return false, nil
 }
 
+// MaybeBody returns the response body with the secrets redacted or an empty string if the response has no body.
 func MaybeBody(resp StatusCoder) string {
 	v := reflect.ValueOf(resp)
 
@@ -74,5 +96,5 @@ func MaybeBody(resp StatusCoder) string {
 
 	result := bodyField.Bytes()
 
-	return string(result)
+	return Redact(string(result))
 }
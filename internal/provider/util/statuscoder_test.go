@@ -46,6 +46,31 @@ func TestStatusOK(t *testing.T) {
 		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
 	}, nil)
 	require.Nil(t, result)
+
+	result = util.StatusOK(management.GetV1RegionsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusUnauthorized},
+	}, nil, util.ReturnNilOnNotFound)
+	require.NotNil(t, result)
+	require.Contains(t, result.Summary, "API key")
+	require.Contains(t, result.Detail, "expired")
+
+	result = util.StatusOK(management.GetV1RegionsResponse{
+		Body:         []byte(`{"adminPassword":"fooBAR12$"}`),
+		HTTPResponse: &http.Response{StatusCode: http.StatusConflict},
+	}, nil)
+	require.NotNil(t, result)
+	require.NotContains(t, result.Detail, "fooBAR12$", "should redact secrets in the response body")
+
+	result = util.StatusOK(management.GetV1RegionsResponse{
+		Body:         []byte(`{"adminPassword":"fooBAR12$"}`),
+		HTTPResponse: &http.Response{StatusCode: http.StatusUnauthorized},
+	}, nil)
+	require.NotNil(t, result)
+	require.NotContains(t, result.Detail, "fooBAR12$", "should redact secrets in the response body")
+
+	result = util.StatusOK(nil, errors.New("Authorization: Bearer buzz"))
+	require.NotNil(t, result)
+	require.NotContains(t, result.Detail, "buzz", "should redact secrets in the error")
 }
 
 type statusCoderNotStruct int
@@ -0,0 +1,78 @@
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Timeouts is the timeouts attribute of a resource that extends or shortens how long creating, updating,
+// and deleting wait for the Management API.
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// TimeoutsSchemaAttribute returns the timeouts attribute that describes the default timeouts.
+func TimeoutsSchemaAttribute(create, update, delete time.Duration) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: `How long the operations wait for the Management API as durations, e.g., "8h" for creating large workspaces.`,
+		Attributes: map[string]schema.Attribute{
+			"create": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How long creating waits. Defaults to %q.", create),
+				Validators:          []validator.String{NewDurationValidator()},
+			},
+			"update": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How long updating waits. Defaults to %q.", update),
+				Validators:          []validator.String{NewDurationValidator()},
+			},
+			"delete": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How long deleting waits. Defaults to %q.", delete),
+				Validators:          []validator.String{NewDurationValidator()},
+			},
+		},
+	}
+}
+
+// CreateTimeout returns the create timeout or the default one if it is not set.
+func (t *Timeouts) CreateTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+
+	return durationOr(t.Create, defaultTimeout)
+}
+
+// UpdateTimeout returns the update timeout or the default one if it is not set.
+func (t *Timeouts) UpdateTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+
+	return durationOr(t.Update, defaultTimeout)
+}
+
+// DeleteTimeout returns the delete timeout or the default one if it is not set.
+func (t *Timeouts) DeleteTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+
+	return durationOr(t.Delete, defaultTimeout)
+}
+
+func durationOr(value types.String, defaultDuration time.Duration) time.Duration {
+	if result, err := ParseDuration(value.ValueString()); err == nil {
+		return result
+	}
+
+	return defaultDuration
+}
@@ -0,0 +1,26 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeouts(t *testing.T) {
+	var undeclared *util.Timeouts
+	require.Equal(t, time.Hour, undeclared.CreateTimeout(time.Hour), "should default without the timeouts attribute")
+	require.Equal(t, time.Hour, undeclared.UpdateTimeout(time.Hour))
+	require.Equal(t, time.Hour, undeclared.DeleteTimeout(time.Hour))
+
+	declared := &util.Timeouts{
+		Create: types.StringValue("8h"),
+		Update: types.StringNull(),
+		Delete: types.StringValue("90m"),
+	}
+	require.Equal(t, 8*time.Hour, declared.CreateTimeout(time.Hour))
+	require.Equal(t, time.Hour, declared.UpdateTimeout(time.Hour), "should default the timeouts that are not set")
+	require.Equal(t, 90*time.Minute, declared.DeleteTimeout(time.Hour))
+}
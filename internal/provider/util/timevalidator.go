@@ -13,7 +13,8 @@ var _ validator.String = &timeValidator{}
 
 // timeValidator validates that a string Attribute's value matches the expected time format.
 type timeValidator struct {
-	message string
+	message       string
+	allowDuration bool
 }
 
 // Description describes the validation in plain text formatting.
@@ -22,6 +23,10 @@ func (v timeValidator) Description(_ context.Context) string {
 		return v.message
 	}
 
+	if v.allowDuration {
+		return "value must be an RFC3339 time string or a duration"
+	}
+
 	return "value must be an RFC3339 time string"
 }
 
@@ -37,8 +42,17 @@ func (v *timeValidator) ValidateString(ctx context.Context, request validator.St
 	}
 
 	value := request.ConfigValue.ValueString()
+	if v.allowDuration {
+		if _, err := ParseDuration(value); err == nil {
+			return
+		}
+	}
+
 	if _, err := parseTime(value); err != nil {
 		v.message = err.Error()
+		if v.allowDuration {
+			v.message = fmt.Sprintf("%s or a duration, e.g., %q", err, "720h")
+		}
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
 			request.Path,
 			v.Description(ctx),
@@ -59,6 +73,17 @@ func NewTimeValidator() validator.String {
 	return &timeValidator{}
 }
 
+// NewTimeOrDurationValidator returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Either matches the string format RFC3339 and is UTC or is a positive Go duration, e.g., "720h".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func NewTimeOrDurationValidator() validator.String {
+	return &timeValidator{allowDuration: true}
+}
+
 // parseTime parses time in RFC3339.
 func parseTime(timeString string) (time.Time, error) {
 	t, err := time.Parse(time.RFC3339, timeString)
@@ -48,3 +48,26 @@ func TestTimeValidator(t *testing.T) {
 	require.NotEmpty(t, resp.Diagnostics)
 	require.NotEqual(t, defaultMessage, v.Description(ctx), "requires UTC")
 }
+
+func TestTimeOrDurationValidator(t *testing.T) {
+	ctx := context.Background()
+
+	v := util.NewTimeOrDurationValidator()
+	defaultMessage := v.Description(ctx)
+	require.Contains(t, defaultMessage, "duration")
+
+	for _, valid := range []string{"2222-01-01T00:00:00Z", "720h", "90m"} {
+		v = util.NewTimeOrDurationValidator()
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(valid)}, resp)
+		require.Empty(t, resp.Diagnostics, valid)
+	}
+
+	for _, invalid := range []string{"tomorrow", "-1h", "2222-01-01T00:00:00+07:00"} {
+		v = util.NewTimeOrDurationValidator()
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(invalid)}, resp)
+		require.NotEmpty(t, resp.Diagnostics, invalid)
+		require.Contains(t, v.Description(ctx), "720h", "shows the error")
+	}
+}
@@ -91,6 +91,19 @@ func Map[A, B any](as []A, f func(A) B) []B {
 	return result
 }
 
+// Filter returns a new list containing the elements of the input list for which the function f returns true.
+// The input list is not modified.
+func Filter[A any](as []A, f func(A) bool) []A {
+	result := make([]A, 0, len(as))
+	for _, a := range as {
+		if f(a) {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
 // MapWithError applies the function f to each element of the input list and returns a new
 // list containing the results. The input list is not modified. The function f
 // should take an element of the input list as its argument and return a value
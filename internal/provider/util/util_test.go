@@ -77,6 +77,13 @@ func TestMapWithError(t *testing.T) {
 	}
 }
 
+func TestFilter(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	require.Equal(t, []int{2, 4}, util.Filter([]int{1, 2, 3, 4}, isEven))
+	require.Empty(t, util.Filter([]int{1, 3}, isEven))
+	require.Empty(t, util.Filter(nil, isEven))
+}
+
 func TestCheckLastN(t *testing.T) {
 	require.False(t, util.CheckLastN([]string{}, 10, "foo"))
 	require.True(t, util.CheckLastN([]string{}, 0, "foo"))
@@ -0,0 +1,346 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceCloneName = "workspace_group_clone"
+)
+
+var _ resource.ResourceWithConfigure = &cloneResource{}
+
+// cloneResource is the resource implementation.
+type cloneResource struct {
+	util.ProviderData
+}
+
+// cloneResourceModel maps the resource schema data.
+type cloneResourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	SourceWorkspaceGroupID types.String   `tfsdk:"source_workspace_group_id"`
+	Name                   types.String   `tfsdk:"name"`
+	AdminPassword          types.String   `tfsdk:"admin_password"`
+	TTL                    types.String   `tfsdk:"ttl"`
+	ExpiresAt              types.String   `tfsdk:"expires_at"`
+	RegionID               types.String   `tfsdk:"region_id"`
+	FirewallRanges         []types.String `tfsdk:"firewall_ranges"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+}
+
+// NewResourceClone is a helper function to simplify the provider implementation.
+func NewResourceClone() resource.Resource {
+	return &cloneResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *cloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceCloneName)
+}
+
+// Schema defines the schema for the resource.
+func (r *cloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a workspace group as a copy of an existing one with this resource, e.g., for a production-like staging environment. The region, the firewall ranges, and the update window of the source workspace group are copied on creation; later changes of the source are not followed. The workspaces and the databases are not copied since the Management API does not offer restoring the databases of another workspace group. The expiration of the source is not copied either; use ttl instead. Destroying the resource terminates the clone.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the clone.",
+			},
+			"source_workspace_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace group to copy. Changing it recreates the clone.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the clone.",
+			},
+			"admin_password": schema.StringAttribute{
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The admin SQL user password of the clone. The password of the source is not copied. If not provided, the server generates a strong password on creation and this attribute exposes it.",
+			},
+			"ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: `The time to live of the clone as a duration, e.g., "72h". The clone expires at its creation time plus the ttl, and changing the ttl moves the expiration accordingly. If not specified on creation, the clone never expires; removing it later keeps the current expiration.`,
+				Validators:          []validator.String{util.NewDurationValidator()},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The expiration timestamp of the clone, or null if it never expires.",
+			},
+			"region_id": schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the region of the clone, the same as of the source.",
+			},
+			"firewall_ranges": schema.ListAttribute{
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The firewall ranges of the clone. They are copied from the source on creation; manage them afterwards with the singlestoredb_workspace_group_firewall resource.",
+			},
+			"created_at": schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the clone was created.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *cloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := uuid.MustParse(plan.SourceWorkspaceGroupID.ValueString())
+	source, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, sourceID, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	if serr := util.StatusOK(source, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if source.JSON200.State == management.TERMINATED {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Cannot clone workspace group %s because it is terminated", sourceID),
+			"Please specify an active workspace group as the source.",
+		)
+
+		return
+	}
+
+	var expiresAt *string
+	if ttl, err := util.ParseDuration(plan.TTL.ValueString()); err == nil {
+		expiresAt = util.Ptr(time.Now().UTC().Add(ttl).Format(time.RFC3339))
+	}
+
+	workspaceGroupCreateResponse, err := r.PostV1WorkspaceGroupsWithResponse(ctx, management.PostV1WorkspaceGroupsJSONRequestBody{
+		AdminPassword:  util.MaybeString(plan.AdminPassword),
+		ExpiresAt:      expiresAt,
+		FirewallRanges: util.StringFirewallRanges(util.FirewallRanges(source.JSON200.FirewallRanges)),
+		Name:           plan.Name.ValueString(),
+		RegionID:       source.JSON200.RegionID,
+		UpdateWindow:   source.JSON200.UpdateWindow,
+	})
+	if serr := util.StatusOK(workspaceGroupCreateResponse, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	id := workspaceGroupCreateResponse.JSON200.WorkspaceGroupID
+	wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout)
+	if werr != nil {
+		resp.Diagnostics.AddError(
+			werr.Summary,
+			werr.Detail,
+		)
+
+		return
+	}
+
+	result := toCloneResourceModel(plan, wg, util.FirstNotEmpty(
+		plan.AdminPassword.ValueString(),
+		util.Deref(workspaceGroupCreateResponse.JSON200.AdminPassword), // Either from input or output.
+	))
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *cloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx,
+		uuid.MustParse(state.ID.ValueString()),
+		&management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{},
+	)
+	if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspaceGroup.JSON200 == nil || workspaceGroup.JSON200.State == management.TERMINATED {
+		resp.State.RemoveResource(ctx)
+
+		return // The clone got terminated externally, e.g., expired, deleting it from the state file to recreate.
+	}
+
+	result := toCloneResourceModel(state, *workspaceGroup.JSON200, state.AdminPassword.ValueString())
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *cloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state cloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan cloneResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := uuid.MustParse(state.ID.ValueString())
+
+	adminPassword := util.MaybeString(plan.AdminPassword)
+	if plan.AdminPassword.Equal(state.AdminPassword) {
+		adminPassword = nil // Not resetting the password, e.g., if it was changed outside of Terraform.
+	}
+
+	var expiresAt *string
+	if ttl, err := util.ParseDuration(plan.TTL.ValueString()); err == nil && !plan.TTL.Equal(state.TTL) {
+		createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+		if err != nil {
+			createdAt = time.Now().UTC()
+		}
+
+		expiresAt = util.Ptr(createdAt.Add(ttl).UTC().Format(time.RFC3339))
+	}
+
+	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+		management.WorkspaceGroupUpdate{
+			AdminPassword: adminPassword,
+			ExpiresAt:     expiresAt,
+			Name:          util.MaybeString(plan.Name),
+		},
+	)
+	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout)
+	if werr != nil {
+		resp.Diagnostics.AddError(
+			werr.Summary,
+			werr.Detail,
+		)
+
+		return
+	}
+
+	result := toCloneResourceModel(plan, wg, plan.AdminPassword.ValueString())
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *cloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := uuid.MustParse(state.ID.ValueString())
+	workspaceGroupDeleteResponse, err := r.DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+		&management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams{Force: util.Ptr(true)}, // Deleting even if workspaces in the clone.
+	)
+	if serr := util.StatusOK(workspaceGroupDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if werr := waitStatusTerminated(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupTerminationTimeout); werr != nil {
+		resp.Diagnostics.AddError(
+			werr.Summary,
+			werr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+func toCloneResourceModel(model cloneResourceModel, workspaceGroup management.WorkspaceGroup, adminPassword string) cloneResourceModel {
+	return cloneResourceModel{
+		ID:                     util.UUIDStringValue(workspaceGroup.WorkspaceGroupID),
+		SourceWorkspaceGroupID: model.SourceWorkspaceGroupID,
+		Name:                   types.StringValue(workspaceGroup.Name),
+		AdminPassword:          types.StringValue(adminPassword),
+		TTL:                    model.TTL,
+		ExpiresAt:              util.MaybeStringValue(workspaceGroup.ExpiresAt),
+		RegionID:               util.UUIDStringValue(workspaceGroup.RegionID),
+		FirewallRanges:         util.FirewallRanges(workspaceGroup.FirewallRanges),
+		CreatedAt:              types.StringValue(workspaceGroup.CreatedAt),
+	}
+}
@@ -0,0 +1,183 @@
+package workspacegroups_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceGroupClone(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	sourceID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	cloneID := uuid.MustParse("4ca3d35a-021d-45ed-86cb-38b8d14ac507")
+	updateWindow := &management.UpdateWindow{Day: 3, Hour: 15} // Set in the SingleStore Portal.
+
+	mu := sync.Mutex{}
+	workspaceGroups := map[uuid.UUID]management.WorkspaceGroup{}
+
+	writeJSON := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(body))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			writeJSON(w, regions)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+
+			id, window := sourceID, updateWindow
+			if input.Name == "staging" {
+				id, window = cloneID, input.UpdateWindow
+				require.Equal(t, updateWindow, input.UpdateWindow, "should copy the update window of the source")
+				require.Equal(t, regions[0].RegionID, input.RegionID, "should copy the region of the source")
+				require.Equal(t, []string{"0.0.0.0/0"}, input.FirewallRanges, "should copy the firewall ranges of the source")
+				require.NotNil(t, input.ExpiresAt, "should resolve the ttl")
+			}
+
+			workspaceGroups[id] = management.WorkspaceGroup{
+				CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+				ExpiresAt:        input.ExpiresAt,
+				FirewallRanges:   util.Ptr(input.FirewallRanges),
+				Name:             input.Name,
+				RegionID:         input.RegionID,
+				State:            management.ACTIVE,
+				UpdateWindow:     window,
+				WorkspaceGroupID: id,
+			}
+			writeJSON(w, struct {
+				AdminPassword    string `json:"adminPassword"`
+				WorkspaceGroupID uuid.UUID
+			}{
+				AdminPassword:    "generated" + input.Name + "BAR12$",
+				WorkspaceGroupID: id,
+			})
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/"):
+			id := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/v1/workspaceGroups/"))
+			workspaceGroup, ok := workspaceGroups[id]
+			require.True(t, ok, "unknown workspace group %s", id)
+
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, workspaceGroup)
+			case http.MethodPatch:
+				require.Equal(t, cloneID, id, "should not modify the source")
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var input management.WorkspaceGroupUpdate
+				require.NoError(t, json.Unmarshal(body, &input))
+				require.Nil(t, input.AdminPassword, "should not reset the password on unrelated updates")
+				if input.UpdateWindow != nil {
+					workspaceGroup.UpdateWindow = input.UpdateWindow
+				}
+
+				if input.Name != nil {
+					workspaceGroup.Name = *input.Name
+				}
+
+				if input.ExpiresAt != nil {
+					workspaceGroup.ExpiresAt = input.ExpiresAt
+				}
+
+				workspaceGroups[id] = workspaceGroup
+				writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: id})
+			case http.MethodDelete:
+				workspaceGroup.State = management.TERMINATED
+				workspaceGroups[id] = workspaceGroup
+				writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: id})
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotImplemented)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cloneExpiresAt := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result, err := time.Parse(time.RFC3339, util.Deref(workspaceGroups[cloneID].ExpiresAt))
+		require.NoError(t, err)
+
+		return result
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupCloneResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "id", cloneID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "source_workspace_group_id", sourceID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "region_id", regions[0].RegionID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "firewall_ranges.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "firewall_ranges.0", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "admin_password", "generatedstagingBAR12$"),
+					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group_clone.staging", "expires_at"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+
+						require.Equal(t, updateWindow, workspaceGroups[cloneID].UpdateWindow, "should copy the update window of the source")
+
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					require.WithinDuration(t, time.Now().Add(72*time.Hour), cloneExpiresAt(), time.Minute)
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupCloneResource).
+					WithWorkspaceGroupCloneResource("staging")("name", cty.StringVal("staging-2")).
+					WithWorkspaceGroupCloneResource("staging")("ttl", cty.StringVal("24h")).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "name", "staging-2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "ttl", "24h"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_clone.staging", "admin_password", "generatedstagingBAR12$"),
+				),
+			},
+		},
+	})
+
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), cloneExpiresAt(), time.Minute, "changing the ttl should move the expiration")
+	require.Equal(t, management.TERMINATED, workspaceGroups[cloneID].State, "destroying the resource should terminate the clone")
+}
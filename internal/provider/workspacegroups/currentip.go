@@ -0,0 +1,107 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// detectCurrentIPRange returns the single address CIDR range of the public IP of the machine running Terraform.
+func detectCurrentIPRange(ctx context.Context) (string, error) {
+	url := util.FirstNotEmpty(os.Getenv(config.EnvCurrentIPServiceURL), config.CurrentIPServiceURL)
+
+	ctx, cancel := context.WithTimeout(ctx, config.HTTPRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := util.NewHTTPClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to detect the public IP with %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+	if err != nil {
+		return "", fmt.Errorf("failed to detect the public IP with %s: %w", url, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to detect the public IP with %s: status code %s", url, http.StatusText(resp.StatusCode))
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return "", fmt.Errorf("failed to detect the public IP with %s: the response %q is not an IP", url, strings.TrimSpace(string(body)))
+	}
+
+	if ip.To4() != nil {
+		return ip.String() + "/32", nil
+	}
+
+	return ip.String() + "/128", nil
+}
+
+// resolveCurrentIPRange detects the current IP range if the plan leaves it to the apply time.
+func resolveCurrentIPRange(ctx context.Context, plan *workspaceGroupResourceModel) *util.SummaryWithDetailError {
+	if !plan.CurrentIPRange.IsUnknown() {
+		return nil
+	}
+
+	detected, err := detectCurrentIPRange(ctx)
+	if err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: "Failed to detect the public IP of the machine running Terraform",
+			Detail: fmt.Sprintf("Either set allow_current_ip to false or specify another service with the %s environment variable.\n\n%s",
+				config.EnvCurrentIPServiceURL, err),
+		}
+	}
+
+	plan.CurrentIPRange = types.StringValue(detected)
+
+	return nil
+}
+
+// withCurrentIPRange adds the current IP range to the firewall ranges if it is not there yet.
+func withCurrentIPRange(firewallRanges []string, currentIPRange types.String) []string {
+	if currentIPRange.IsNull() || currentIPRange.IsUnknown() || util.Any(firewallRanges, currentIPRange.ValueString()) {
+		return firewallRanges
+	}
+
+	return append(firewallRanges, currentIPRange.ValueString())
+}
+
+// withoutCurrentIPRange hides the current IP range from the firewall ranges unless it is declared explicitly.
+//
+// The current IP range is forgotten if it is no longer allowed, so that the next plan adds it back.
+func withoutCurrentIPRange(result workspaceGroupResourceModel, source workspaceGroupResourceModel) workspaceGroupResourceModel {
+	result.AllowCurrentIP = types.BoolValue(source.AllowCurrentIP.ValueBool()) // Null after import.
+	result.CurrentIPRange = types.StringNull()
+	if !source.AllowCurrentIP.ValueBool() || source.CurrentIPRange.IsNull() || source.CurrentIPRange.IsUnknown() {
+		return result
+	}
+
+	if !util.Any(util.StringFirewallRanges(result.FirewallRanges), source.CurrentIPRange.ValueString()) {
+		return result
+	}
+
+	result.CurrentIPRange = source.CurrentIPRange
+	if !util.Any(util.StringFirewallRanges(source.FirewallRanges), source.CurrentIPRange.ValueString()) {
+		result.FirewallRanges = util.Filter(result.FirewallRanges, func(fr types.String) bool {
+			return !fr.Equal(source.CurrentIPRange)
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,228 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/workspaces"
+)
+
+const (
+	DataSourceExportName = "workspace_group_export"
+)
+
+// workspaceGroupExportDataSource is the data source implementation.
+type workspaceGroupExportDataSource struct {
+	util.ProviderData
+}
+
+// workspaceGroupExportDataSourceModel maps the data source schema data.
+type workspaceGroupExportDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Configuration types.String `tfsdk:"configuration"`
+	ImportBlocks  types.String `tfsdk:"import_blocks"`
+}
+
+var _ datasource.DataSourceWithConfigure = &workspaceGroupExportDataSource{}
+
+// NewDataSourceExport is a helper function to simplify the provider implementation.
+func NewDataSourceExport() datasource.DataSource {
+	return &workspaceGroupExportDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceGroupExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceExportName)
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceGroupExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Export an existing workspace group and its workspaces as Terraform configuration with this data source. Write the configuration and the import blocks to a *.tf file to bring a deployment that was created outside of Terraform under its management. The admin password is not exported because the Management API does not return it.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace group.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"configuration": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource blocks of the workspace group and its workspaces in HCL. The workspaces refer to the workspace group by its resource address.",
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The import blocks of the workspace group and its workspaces in HCL, one for each resource block of the configuration.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceGroupExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceGroupExportDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			"Invalid workspace group ID",
+			"The workspace group ID should be a valid UUID",
+		)
+
+		return
+	}
+
+	workspaceGroup, err := d.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	if serr := util.StatusOK(workspaceGroup, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if isTerminating(*workspaceGroup.JSON200) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			fmt.Sprintf("Workspace group %s is terminated", id),
+			"Make sure to set the workspace group ID of the workspace group that exists.",
+		)
+
+		return
+	}
+
+	workspaceList, err := d.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{WorkspaceGroupID: id})
+	if serr := util.StatusOK(workspaceList, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	configuration, importBlocks := exportWorkspaceGroup(*workspaceGroup.JSON200, util.Deref(workspaceList.JSON200))
+
+	result := workspaceGroupExportDataSourceModel{
+		ID:            data.ID,
+		Configuration: types.StringValue(configuration),
+		ImportBlocks:  types.StringValue(importBlocks),
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceGroupExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// exportWorkspaceGroup renders the resource blocks and the import blocks of the workspace group and its workspaces.
+func exportWorkspaceGroup(workspaceGroup management.WorkspaceGroup, workspaceList []management.Workspace) (string, string) {
+	groupType := strings.Join([]string{config.ProviderName, ResourceName}, "_")
+	workspaceType := strings.Join([]string{config.ProviderName, workspaces.ResourceName}, "_")
+
+	labels := map[string]bool{}
+	groupLabel := uniqueLabel(labels, workspaceGroup.Name)
+
+	configuration := &strings.Builder{}
+	importBlocks := &strings.Builder{}
+
+	fmt.Fprintf(configuration, "resource %q %q {\n", groupType, groupLabel)
+	fmt.Fprintf(configuration, "  name            = %s\n", hclString(workspaceGroup.Name))
+	fmt.Fprintf(configuration, "  firewall_ranges = [%s]\n", strings.Join(util.Map(util.Deref(workspaceGroup.FirewallRanges), hclString), ", "))
+	if workspaceGroup.ExpiresAt != nil {
+		fmt.Fprintf(configuration, "  expires_at      = %s\n", hclString(*workspaceGroup.ExpiresAt))
+	}
+	fmt.Fprintf(configuration, "  region_id       = %s\n", hclString(workspaceGroup.RegionID.String()))
+	fmt.Fprintf(configuration, "}\n")
+
+	writeImportBlock(importBlocks, groupType, groupLabel, workspaceGroup.WorkspaceGroupID.String())
+
+	sort.Slice(workspaceList, func(i, j int) bool { return workspaceList[i].Name < workspaceList[j].Name })
+	for _, w := range workspaceList {
+		if w.State == management.WorkspaceStateTERMINATED {
+			continue
+		}
+
+		label := uniqueLabel(labels, w.Name)
+
+		fmt.Fprintf(configuration, "\nresource %q %q {\n", workspaceType, label)
+		fmt.Fprintf(configuration, "  name               = %s\n", hclString(w.Name))
+		fmt.Fprintf(configuration, "  workspace_group_id = %s.%s.%s\n", groupType, groupLabel, config.IDAttribute)
+		fmt.Fprintf(configuration, "  size               = %s\n", hclString(w.Size))
+		fmt.Fprintf(configuration, "  suspended          = %t\n", w.State == management.WorkspaceStateSUSPENDED)
+		fmt.Fprintf(configuration, "}\n")
+
+		importBlocks.WriteString("\n")
+		writeImportBlock(importBlocks, workspaceType, label, w.WorkspaceID.String())
+	}
+
+	return configuration.String(), importBlocks.String()
+}
+
+func writeImportBlock(b *strings.Builder, resourceType, label, id string) {
+	fmt.Fprintf(b, "import {\n")
+	fmt.Fprintf(b, "  to = %s.%s\n", resourceType, label)
+	fmt.Fprintf(b, "  id = %s\n", hclString(id))
+	fmt.Fprintf(b, "}\n")
+}
+
+// hclString quotes the string for HCL, escaping the template sequences.
+func hclString(s string) string {
+	result := strconv.Quote(s)
+	result = strings.ReplaceAll(result, "${", "$${")
+	result = strings.ReplaceAll(result, "%{", "%%{")
+
+	return result
+}
+
+// uniqueLabel converts the name into a valid Terraform resource name that is not in the labels yet.
+func uniqueLabel(labels map[string]bool, name string) string {
+	base := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '_'
+		}
+	}, name)
+
+	if base == "" || (base[0] >= '0' && base[0] <= '9') || base[0] == '-' {
+		base = "_" + base // Resource names start with a letter or an underscore.
+	}
+
+	result := base
+	for i := 2; labels[result]; i++ {
+		result = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	labels[result] = true
+
+	return result
+}
@@ -0,0 +1,130 @@
+package workspacegroups_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestExportsWorkspaceGroup(t *testing.T) {
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		ExpiresAt:        util.Ptr("2222-01-01T00:00:00Z"),
+		FirewallRanges:   util.Ptr([]string{"127.0.0.1/32", "10.0.0.0/8"}),
+		Name:             "Analytics ${env}",
+		RegionID:         uuid.MustParse("0aa1aff3-4092-4a0c-bf36-da54e85a4fdf"),
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	workspaces := []management.Workspace{
+		{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             "reader",
+			Size:             "S-0",
+			State:            management.WorkspaceStateSUSPENDED,
+			WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+			WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+		},
+		{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             "1-writer",
+			Size:             "S-00",
+			State:            management.WorkspaceStateACTIVE,
+			WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+			WorkspaceID:      uuid.MustParse("a2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+		},
+		{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             "gone",
+			Size:             "S-00",
+			State:            management.WorkspaceStateTERMINATED,
+			WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+			WorkspaceID:      uuid.MustParse("b2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Add("Content-Type", "json")
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/workspaceGroups/%s", workspaceGroup.WorkspaceGroupID):
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case "/v1/workspaces":
+			require.Equal(t, workspaceGroup.WorkspaceGroupID.String(), r.URL.Query().Get("workspaceGroupID"))
+			_, err := w.Write(testutil.MustJSON(workspaces))
+			require.NoError(t, err)
+		default:
+			require.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	expectedConfiguration := `resource "singlestoredb_workspace_group" "analytics___env_" {
+  name            = "Analytics $${env}"
+  firewall_ranges = ["127.0.0.1/32", "10.0.0.0/8"]
+  expires_at      = "2222-01-01T00:00:00Z"
+  region_id       = "0aa1aff3-4092-4a0c-bf36-da54e85a4fdf"
+}
+
+resource "singlestoredb_workspace" "_1-writer" {
+  name               = "1-writer"
+  workspace_group_id = singlestoredb_workspace_group.analytics___env_.id
+  size               = "S-00"
+  suspended          = false
+}
+
+resource "singlestoredb_workspace" "reader" {
+  name               = "reader"
+  workspace_group_id = singlestoredb_workspace_group.analytics___env_.id
+  size               = "S-0"
+  suspended          = true
+}
+`
+
+	expectedImportBlocks := `import {
+  to = singlestoredb_workspace_group.analytics___env_
+  id = "e1a0a960-8591-4196-bb26-f53f0f8e35ce"
+}
+
+import {
+  to = singlestoredb_workspace._1-writer
+  id = "a2a1a960-8591-4156-bb26-f53f0f8e35ce"
+}
+
+import {
+  to = singlestoredb_workspace.reader
+  id = "f2a1a960-8591-4156-bb26-f53f0f8e35ce"
+}
+`
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupExportDataSource).
+					WithWorkspaceGroupExportDataSource("this")(config.IDAttribute, cty.StringVal(workspaceGroup.WorkspaceGroupID.String())).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_group_export.this", config.IDAttribute, workspaceGroup.WorkspaceGroupID.String()),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_group_export.this", "configuration", expectedConfiguration),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_group_export.this", "import_blocks", expectedImportBlocks),
+				),
+			},
+		},
+	})
+}
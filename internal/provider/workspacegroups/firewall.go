@@ -0,0 +1,109 @@
+package workspacegroups
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// managedFirewallRanges returns the declared firewall ranges that are present in the actual firewall ranges.
+//
+// This hides the ranges that are added out-of-band from the state.
+func managedFirewallRanges(declared, actual []types.String) []types.String {
+	actualStrings := util.StringFirewallRanges(actual)
+	result := make([]types.String, 0, len(declared))
+	for _, d := range declared {
+		if util.Any(actualStrings, d.ValueString()) {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// mergeFirewallRanges replaces the previously declared firewall ranges with the desired ones,
+// preserving the actual ranges that were never declared.
+func mergeFirewallRanges(actual, previous, desired []string) []string {
+	result := make([]string, 0, len(actual)+len(desired))
+	for _, a := range actual {
+		if !util.Any(previous, a) && !util.Any(result, a) {
+			result = append(result, a)
+		}
+	}
+
+	for _, d := range desired {
+		if !util.Any(result, d) {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// declaredFirewallRangesKey is the private state key of the firewall ranges that the resource applied last.
+const declaredFirewallRangesKey = "declared_firewall_ranges"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setDeclaredFirewallRanges remembers the applied firewall ranges, so that the next update knows
+// which of the actual ranges it manages regardless of what the state shows.
+func setDeclaredFirewallRanges(ctx context.Context, private privateStateSetter, ranges []string) diag.Diagnostics {
+	value, err := json.Marshal(ranges)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to save the declared firewall ranges", err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, declaredFirewallRangesKey, value)
+}
+
+// previousFirewallRanges returns the firewall ranges that the resource applied last.
+//
+// The state is not enough since it shows all the actual ranges, including the out-of-band ones,
+// while unmanaged ranges are not ignored. It is only used for the resources that were applied
+// before the private state was recorded.
+func previousFirewallRanges(ctx context.Context, private privateStateGetter, state workspaceGroupResourceModel) ([]string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, declaredFirewallRangesKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if value == nil {
+		if !state.IgnoreUnmanagedFirewallRanges.ValueBool() {
+			return nil, diags // Keeping all the actual ranges since the managed ones are unknown.
+		}
+
+		return withCurrentIPRange(util.StringFirewallRanges(state.FirewallRanges), state.CurrentIPRange), diags
+	}
+
+	var result []string
+	if err := json.Unmarshal(value, &result); err != nil {
+		diags.AddError("Failed to load the declared firewall ranges", err.Error())
+	}
+
+	return result, diags
+}
+
+// firewallLocks serializes the read-modify-write cycles of the firewall ranges of each workspace group
+// since the Management API replaces the firewall ranges as a whole.
+var firewallLocks sync.Map
+
+// lockFirewall locks the firewall ranges of the workspace group and returns the unlock function.
+func lockFirewall(id string) func() {
+	mu, _ := firewallLocks.LoadOrStore(id, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	return mu.(*sync.Mutex).Unlock
+}
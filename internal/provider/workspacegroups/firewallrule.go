@@ -0,0 +1,275 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceFirewallRuleName = "workspace_group_firewall_rule"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &firewallRuleResource{}
+	_ resource.ResourceWithImportState = &firewallRuleResource{}
+)
+
+// firewallRuleResource is the resource implementation.
+type firewallRuleResource struct {
+	util.ProviderData
+}
+
+// firewallRuleResourceModel maps the resource schema data.
+type firewallRuleResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
+	CIDR             types.String `tfsdk:"cidr"`
+}
+
+// NewResourceFirewallRule is a helper function to simplify the provider implementation.
+func NewResourceFirewallRule() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *firewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceFirewallRuleName)
+}
+
+// Schema defines the schema for the resource.
+func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allow a single CIDR range in the firewall of an existing workspace group with this resource, so that different modules can each contribute their own ranges to a shared workspace group. The provider adds and removes only the range of the resource, keeping the other ranges as is. If the workspace group resource is managed too, set its ignore_unmanaged_firewall_ranges to true so that it does not remove the ranges of the rules.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the rule in the format <workspace_group_id>/<cidr>.",
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the existing workspace group to allow the range for.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"cidr": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: `The allowed CIDR range, e.g., "10.0.0.0/8".`,
+				Validators:          []validator.String{util.NewCIDRValidator()},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan firewallRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, serr := r.update(ctx, plan, func(actual []string) []string {
+		return mergeFirewallRanges(actual, nil, []string{plan.CIDR.ValueString()})
+	})
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if id == nil {
+		resp.Diagnostics.AddError(
+			"Cannot allow the firewall range",
+			fmt.Sprintf("Cannot allow the range %s because the workspace group %s does not exist or is terminated.", plan.CIDR.ValueString(), plan.WorkspaceGroupID.ValueString()),
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(firewallRuleID(plan.WorkspaceGroupID.ValueString(), plan.CIDR.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+// The rule is removed from the state if the range is no longer allowed, so that the next apply adds it back.
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceGroup, serr := r.get(ctx, state)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspaceGroup == nil || !util.Any(util.Deref(workspaceGroup.FirewallRanges), state.CIDR.ValueString()) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.ID = types.StringValue(firewallRuleID(state.WorkspaceGroupID.ValueString(), state.CIDR.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// All the attributes require replacement, so there is nothing to update.
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan firewallRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state firewallRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The range of a terminated workspace group is gone already, so a nil ID is not an error.
+	if _, serr := r.update(ctx, state, func(actual []string) []string {
+		return util.Filter(actual, func(fr string) bool { return fr != state.CIDR.ValueString() })
+	}); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *firewallRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ImportState results in Terraform managing the resource that was not previously managed.
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspaceGroupID, cidr, found := strings.Cut(req.ID, "/")
+	_, uerr := uuid.Parse(workspaceGroupID)
+	_, _, cerr := net.ParseCIDR(cidr)
+	if !found || uerr != nil || cerr != nil {
+		resp.Diagnostics.AddError(
+			"Invalid firewall rule ID",
+			fmt.Sprintf("The ID should be in the format <workspace_group_id>/<cidr>, e.g., %q, got %q.", firewallRuleID(uuid.Nil.String(), "10.0.0.0/8"), req.ID),
+		)
+
+		return
+	}
+
+	diags := resp.State.Set(ctx, &firewallRuleResourceModel{
+		ID:               types.StringValue(req.ID),
+		WorkspaceGroupID: types.StringValue(workspaceGroupID),
+		CIDR:             types.StringValue(cidr),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// get returns the workspace group or nil if it is terminated.
+func (r *firewallRuleResource) get(ctx context.Context, model firewallRuleResourceModel) (*management.WorkspaceGroup, *util.SummaryWithDetailError) {
+	workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx,
+		uuid.MustParse(model.WorkspaceGroupID.ValueString()),
+		&management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{},
+	)
+	if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
+		return nil, serr
+	}
+
+	if workspaceGroup.JSON200 == nil || isTerminating(*workspaceGroup.JSON200) {
+		return nil, nil
+	}
+
+	return workspaceGroup.JSON200, nil
+}
+
+// update replaces the firewall ranges of the workspace group with the changed ones
+// and returns the ID of the workspace group or nil if it is terminated.
+//
+// The cycle is locked per workspace group, so that the rules of the same workspace group do not overwrite each other.
+func (r *firewallRuleResource) update(ctx context.Context, model firewallRuleResourceModel, change func(actual []string) []string) (*management.WorkspaceGroupID, *util.SummaryWithDetailError) {
+	defer lockFirewall(uuid.MustParse(model.WorkspaceGroupID.ValueString()).String())()
+
+	workspaceGroup, serr := r.get(ctx, model)
+	if serr != nil || workspaceGroup == nil {
+		return nil, serr
+	}
+
+	actual := util.Deref(workspaceGroup.FirewallRanges)
+	desired := change(actual)
+	if sameFirewallRanges(util.FirewallRanges(&actual), util.FirewallRanges(&desired)) {
+		return &workspaceGroup.WorkspaceGroupID, nil
+	}
+
+	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, workspaceGroup.WorkspaceGroupID,
+		management.WorkspaceGroupUpdate{
+			FirewallRanges: &desired,
+		},
+	)
+	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+		return nil, serr
+	}
+
+	if _, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, workspaceGroup.WorkspaceGroupID, config.WorkspaceGroupCreationTimeout); werr != nil {
+		return nil, werr
+	}
+
+	return &workspaceGroup.WorkspaceGroupID, nil
+}
+
+func firewallRuleID(workspaceGroupID, cidr string) string {
+	return strings.Join([]string{workspaceGroupID, cidr}, "/")
+}
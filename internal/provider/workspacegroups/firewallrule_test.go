@@ -0,0 +1,150 @@
+package workspacegroups_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceGroupFirewallRule(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	unmanagedFirewallRange := "203.0.113.0/24"
+
+	mu := sync.Mutex{}
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{unmanagedFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.NotNil(t, input.FirewallRanges)
+			workspaceGroup.FirewallRanges = input.FirewallRanges
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	firewallRanges := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return util.Deref(workspaceGroup.FirewallRanges)
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupFirewallRuleResource).
+					WithWorkspaceGroupFirewallRuleResource("office")("cidr", cty.StringVal("192.0.2.1")).
+					String(),
+				ExpectError: regexp.MustCompile(`CIDR`),
+			},
+			{
+				Config: examples.WorkspaceGroupFirewallRuleResource, // The rules are created concurrently.
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall_rule.office", config.IDAttribute, workspaceGroupID.String()+"/192.0.2.0/24"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall_rule.ci", "cidr", "198.51.100.7/32"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.ElementsMatch(t, []string{unmanagedFirewallRange, "192.0.2.0/24", "198.51.100.7/32"}, firewallRanges(), "should not lose any of the concurrent updates")
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupFirewallRuleResource).
+					WithWorkspaceGroupFirewallRuleResource("ci")("cidr", cty.StringVal("198.51.100.8/32")).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall_rule.ci", "cidr", "198.51.100.8/32"),
+			},
+			{
+				PreConfig: func() {
+					require.ElementsMatch(t, []string{unmanagedFirewallRange, "192.0.2.0/24", "198.51.100.8/32"}, firewallRanges(), "should replace the range")
+				},
+				ResourceName:  "singlestoredb_workspace_group_firewall_rule.office",
+				ImportState:   true,
+				ImportStateId: "foo/10.0.0.0/8",
+				ExpectError:   regexp.MustCompile("Invalid firewall rule ID"),
+			},
+			{
+				ResourceName:      "singlestoredb_workspace_group_firewall_rule.office",
+				ImportState:       true,
+				ImportStateId:     workspaceGroupID.String() + "/192.0.2.0/24",
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	require.Equal(t, []string{unmanagedFirewallRange}, firewallRanges(), "should keep the ranges that are not managed by the rules")
+}
+
+func TestWorkspaceGroupFirewallRuleTerminatedWorkspaceGroup(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+		State:            management.TERMINATED,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodGet, r.Method, "should not update a terminated workspace group")
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.WorkspaceGroupFirewallRuleResource,
+				ExpectError: regexp.MustCompile("does not exist or is terminated"),
+			},
+		},
+	})
+}
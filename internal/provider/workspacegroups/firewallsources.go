@@ -0,0 +1,429 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceFirewallName = "workspace_group_firewall"
+
+	firewallStrategyMerge   = "merge"
+	firewallStrategyReplace = "replace"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &firewallResource{}
+	_ resource.ResourceWithModifyPlan  = &firewallResource{}
+	_ resource.ResourceWithImportState = &firewallResource{}
+)
+
+// firewallResource is the resource implementation.
+type firewallResource struct {
+	util.ProviderData
+}
+
+// firewallResourceModel maps the resource schema data.
+type firewallResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
+	Sources          types.Map    `tfsdk:"sources"`
+	Strategy         types.String `tfsdk:"strategy"`
+	Ranges           types.Set    `tfsdk:"ranges"`
+}
+
+// NewResourceFirewall is a helper function to simplify the provider implementation.
+func NewResourceFirewall() resource.Resource {
+	return &firewallResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *firewallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceFirewallName)
+}
+
+// Schema defines the schema for the resource.
+func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the firewall of an existing workspace group as a composition of named sources of CIDR ranges with this resource, e.g., a static list, the office IPs from a data source, and the outputs of other modules. The ranges of all the sources are deduplicated and applied in a single update. Each update reads the current ranges right before writing, and the updates of the same workspace group by this provider are serialized. If the workspace group resource is managed too, set its ignore_unmanaged_firewall_ranges to true so that it does not remove the composed ranges.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the workspace group.",
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the existing workspace group to manage the firewall of.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"sources": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+				MarkdownDescription: `The sets of allowed CIDR ranges by the names of their sources, e.g., { static = ["10.0.0.0/8"], office = data.http.office_ips.ranges }. A range may appear in several sources.`,
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.ValueStringsAre(util.NewCIDRValidator())),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(firewallStrategyMerge),
+				MarkdownDescription: fmt.Sprintf("How the composed ranges are applied, either '%s' or '%s'. With '%s', the ranges of the workspace group that the sources never contained are kept, and only the ranges this resource added are removed, also on deletion. With '%s', the firewall ranges of the workspace group equal the composed ranges exactly, and any other range is shown as drift and removed; on deletion, the ranges are kept as is. Defaults to '%s'.", firewallStrategyMerge, firewallStrategyReplace, firewallStrategyMerge, firewallStrategyReplace, firewallStrategyMerge),
+				Validators:          []validator.String{stringvalidator.OneOf(firewallStrategyMerge, firewallStrategyReplace)},
+			},
+			"ranges": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The deduplicated ranges of all the sources that are allowed. With the replace strategy, these are all the firewall ranges of the workspace group.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan firewallResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, nil, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+// With the merge strategy, the ranges that are no longer allowed are forgotten, so that the next apply adds them back.
+// With the replace strategy, all the actual ranges are read, so that the ranges added out-of-band show as drift.
+func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceGroup, serr := r.get(ctx, state.WorkspaceGroupID.ValueString())
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspaceGroup == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	actual := util.Deref(workspaceGroup.FirewallRanges)
+	ranges := actual
+	if state.Strategy.ValueString() != firewallStrategyReplace {
+		ranges = util.Filter(util.StringFirewallRanges(setStrings(state.Ranges)), func(fr string) bool {
+			return util.Any(actual, fr)
+		})
+	}
+
+	state.ID = types.StringValue(workspaceGroup.WorkspaceGroupID.String())
+	state.Ranges, diags = types.SetValueFrom(ctx, types.StringType, sortedFirewallRanges(ranges))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state firewallResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan firewallResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, util.StringFirewallRanges(setStrings(state.Ranges)), &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state firewallResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Strategy.ValueString() == firewallStrategyReplace {
+		return // Removing all the ranges would block all the inbound requests.
+	}
+
+	previous := util.StringFirewallRanges(setStrings(state.Ranges))
+	if _, serr := r.update(ctx, state.WorkspaceGroupID.ValueString(), func(actual []string) []string {
+		return mergeFirewallRanges(actual, previous, nil)
+	}); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *firewallResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ModifyPlan plans the composed ranges, so that a change of any source shows up in the plan.
+func (r *firewallResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *firewallResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	ranges, known := composeFirewallRanges(plan.Sources)
+	if !known {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("ranges"), types.SetUnknown(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	planned, diags := types.SetValueFrom(ctx, types.StringType, ranges)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("ranges"), planned)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState results in Terraform managing the resource that was not previously managed.
+//
+// The imported resource uses the merge strategy and owns all the current ranges,
+// so that the first apply removes the ranges that the sources do not contain.
+func (r *firewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	diags := resp.State.Set(ctx, &firewallResourceModel{
+		ID:               types.StringValue(req.ID),
+		WorkspaceGroupID: types.StringValue(req.ID),
+		Sources:          types.MapNull(types.SetType{ElemType: types.StringType}),
+		Strategy:         types.StringValue(firewallStrategyMerge),
+		Ranges:           types.SetNull(types.StringType),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceGroup, serr := r.get(ctx, req.ID)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspaceGroup == nil {
+		resp.Diagnostics.AddError(
+			"Cannot import the firewall",
+			fmt.Sprintf("The workspace group %s does not exist or is terminated.", req.ID),
+		)
+
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("ranges"), sortedFirewallRanges(util.Deref(workspaceGroup.FirewallRanges)))
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply writes the composed ranges of the plan, replacing the previously applied ones, and sets the state.
+func (r *firewallResource) apply(ctx context.Context, plan firewallResourceModel, previous []string, state *tfsdk.State, diagnostics *diag.Diagnostics) {
+	ranges, _ := composeFirewallRanges(plan.Sources) // Known during apply.
+
+	id, serr := r.update(ctx, plan.WorkspaceGroupID.ValueString(), func(actual []string) []string {
+		if plan.Strategy.ValueString() == firewallStrategyReplace {
+			return ranges
+		}
+
+		return mergeFirewallRanges(actual, previous, ranges)
+	})
+	if serr != nil {
+		diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if id == nil {
+		diagnostics.AddError(
+			"Cannot update the firewall",
+			fmt.Sprintf("The workspace group %s does not exist or is terminated.", plan.WorkspaceGroupID.ValueString()),
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	var diags diag.Diagnostics
+	plan.Ranges, diags = types.SetValueFrom(ctx, types.StringType, ranges)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	diagnostics.Append(state.Set(ctx, &plan)...)
+}
+
+// get returns the workspace group or nil if it is terminated.
+func (r *firewallResource) get(ctx context.Context, workspaceGroupID string) (*management.WorkspaceGroup, *util.SummaryWithDetailError) {
+	id, err := uuid.Parse(workspaceGroupID)
+	if err != nil {
+		return nil, &util.SummaryWithDetailError{
+			Summary: "Invalid workspace group ID",
+			Detail:  "The workspace group ID should be a valid UUID",
+		}
+	}
+
+	workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
+		return nil, serr
+	}
+
+	if workspaceGroup.JSON200 == nil || isTerminating(*workspaceGroup.JSON200) {
+		return nil, nil
+	}
+
+	return workspaceGroup.JSON200, nil
+}
+
+// update replaces the firewall ranges of the workspace group with the changed ones
+// and returns the ID of the workspace group or nil if it is terminated.
+//
+// The cycle is locked per workspace group, so that the firewall rules of the same workspace group do not overwrite it.
+func (r *firewallResource) update(ctx context.Context, workspaceGroupID string, change func(actual []string) []string) (*management.WorkspaceGroupID, *util.SummaryWithDetailError) {
+	defer lockFirewall(uuid.MustParse(workspaceGroupID).String())()
+
+	workspaceGroup, serr := r.get(ctx, workspaceGroupID)
+	if serr != nil || workspaceGroup == nil {
+		return nil, serr
+	}
+
+	actual := util.Deref(workspaceGroup.FirewallRanges)
+	desired := change(actual)
+	if sameFirewallRanges(util.FirewallRanges(&actual), util.FirewallRanges(&desired)) {
+		return &workspaceGroup.WorkspaceGroupID, nil
+	}
+
+	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, workspaceGroup.WorkspaceGroupID,
+		management.WorkspaceGroupUpdate{
+			FirewallRanges: &desired,
+		},
+	)
+	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+		return nil, serr
+	}
+
+	if _, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, workspaceGroup.WorkspaceGroupID, config.WorkspaceGroupCreationTimeout); werr != nil {
+		return nil, werr
+	}
+
+	return &workspaceGroup.WorkspaceGroupID, nil
+}
+
+// composeFirewallRanges returns the sorted and deduplicated ranges of all the sources
+// and whether all of them are known.
+func composeFirewallRanges(sources types.Map) ([]string, bool) {
+	if sources.IsUnknown() {
+		return nil, false
+	}
+
+	result := []string{}
+	for _, source := range sources.Elements() {
+		set, ok := source.(types.Set)
+		if !ok || set.IsUnknown() {
+			return nil, false
+		}
+
+		for _, fr := range setStrings(set) {
+			if fr.IsUnknown() {
+				return nil, false
+			}
+
+			if !fr.IsNull() && !util.Any(result, fr.ValueString()) {
+				result = append(result, fr.ValueString())
+			}
+		}
+	}
+
+	return sortedFirewallRanges(result), true
+}
+
+// setStrings returns the string elements of the set.
+func setStrings(set types.Set) []types.String {
+	result := make([]types.String, 0, len(set.Elements()))
+	for _, e := range set.Elements() {
+		if s, ok := e.(types.String); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func sortedFirewallRanges(frs []string) []string {
+	result := append([]string{}, frs...)
+	sort.Strings(result)
+
+	return result
+}
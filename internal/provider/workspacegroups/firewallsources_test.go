@@ -0,0 +1,134 @@
+package workspacegroups_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceGroupFirewall(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	unmanagedFirewallRange := "203.0.113.0/24"
+	outOfBandFirewallRange := "203.0.113.9/32"
+
+	mu := sync.Mutex{}
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{unmanagedFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.NotNil(t, input.FirewallRanges)
+			workspaceGroup.FirewallRanges = input.FirewallRanges
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	firewallRanges := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return util.Deref(workspaceGroup.FirewallRanges)
+	}
+
+	sources := cty.MapVal(map[string]cty.Value{
+		"static": cty.SetVal([]cty.Value{cty.StringVal("10.0.0.0/8")}),
+		"office": cty.SetVal([]cty.Value{cty.StringVal("192.0.2.0/24"), cty.StringVal("10.0.0.0/8")}), // Overlapping with the static source.
+	})
+
+	merged := testutil.UpdatableConfig(examples.WorkspaceGroupFirewallResource).
+		WithWorkspaceGroupFirewallResource("this")("sources", sources).
+		String()
+
+	replaced := testutil.UpdatableConfig(examples.WorkspaceGroupFirewallResource).
+		WithWorkspaceGroupFirewallResource("this")("sources", sources).
+		WithWorkspaceGroupFirewallResource("this")("strategy", cty.StringVal("replace")).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupFirewallResource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall.this", config.IDAttribute, workspaceGroupID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall.this", "strategy", "merge"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall.this", "ranges.#", "3"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.ElementsMatch(t, []string{unmanagedFirewallRange, "10.0.0.0/8", "192.0.2.0/24", "198.51.100.0/24"}, firewallRanges(), "should merge the composed ranges")
+				},
+				Config: merged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall.this", "ranges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("singlestoredb_workspace_group_firewall.this", "ranges.*", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemAttr("singlestoredb_workspace_group_firewall.this", "ranges.*", "192.0.2.0/24"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.ElementsMatch(t, []string{unmanagedFirewallRange, "10.0.0.0/8", "192.0.2.0/24"}, firewallRanges(), "should remove only the range that was dropped from the sources")
+				},
+				Config: replaced,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group_firewall.this", "strategy", "replace"),
+			},
+			{
+				PreConfig: func() {
+					require.ElementsMatch(t, []string{"10.0.0.0/8", "192.0.2.0/24"}, firewallRanges(), "should remove the unmanaged range")
+
+					mu.Lock()
+					defer mu.Unlock()
+
+					workspaceGroup.FirewallRanges = util.Ptr(append(util.Deref(workspaceGroup.FirewallRanges), outOfBandFirewallRange))
+				},
+				Config:             replaced,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true, // The range added out-of-band shows as drift.
+			},
+		},
+	})
+
+	require.ElementsMatch(t, []string{"10.0.0.0/8", "192.0.2.0/24", outOfBandFirewallRange}, firewallRanges(), "should keep the ranges on deletion with the replace strategy")
+}
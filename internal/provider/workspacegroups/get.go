@@ -21,7 +21,7 @@ const (
 
 // workspaceGroupsDataSourceGet is the data source implementation.
 type workspaceGroupsDataSourceGet struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspaceGroupDataSourceModel maps workspace groups schema data.
@@ -127,7 +127,7 @@ func (d *workspaceGroupsDataSourceGet) Configure(_ context.Context, req datasour
 		return // Should not return an error for unknown reasons.
 	}
 
-	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 func newWorkspaceGroupDataSourceSchemaAttributes(conf workspaceGroupDataSourceSchemaConfig) map[string]schema.Attribute {
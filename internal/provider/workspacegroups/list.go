@@ -17,7 +17,7 @@ const (
 
 // workspaceGroupsDataSourceList is the data source implementation.
 type workspaceGroupsDataSourceList struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspaceGroupsListDataSourceModel maps the data source schema data.
@@ -90,7 +90,7 @@ func (d *workspaceGroupsDataSourceList) Configure(_ context.Context, req datasou
 		return // Should not return an error for unknown reasons.
 	}
 
-	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 func toWorkspaceGroupDataSourceModel(workspaceGroup management.WorkspaceGroup) workspaceGroupDataSourceModel {
@@ -0,0 +1,62 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// regionsCache keeps the regions by the client, so that planning many workspace groups lists the regions once.
+var regionsCache sync.Map
+
+// listRegions returns the regions, listing them only on the first call for the client unless the provider caches them between runs.
+func listRegions(ctx context.Context, pd util.ProviderData) ([]management.Region, *util.SummaryWithDetailError) {
+	if regions, ok := regionsCache.Load(pd.ClientWithResponsesInterface); ok {
+		return regions.([]management.Region), nil
+	}
+
+	result, ok := pd.RegionsCache.Load()
+	if !ok {
+		regions, err := pd.GetV1RegionsWithResponse(ctx, &management.GetV1RegionsParams{})
+		if serr := util.StatusOK(regions, err); serr != nil {
+			return nil, serr
+		}
+
+		result = util.Deref(regions.JSON200)
+		pd.RegionsCache.Store(result)
+	}
+
+	regionsCache.Store(pd.ClientWithResponsesInterface, result)
+
+	return result, nil
+}
+
+// resolveRegionID returns the ID of the region with the name of the cloud provider.
+func resolveRegionID(ctx context.Context, pd util.ProviderData, cloudProvider, regionName string) (uuid.UUID, *util.SummaryWithDetailError) {
+	regions, serr := listRegions(ctx, pd)
+	if serr != nil {
+		return uuid.Nil, serr
+	}
+
+	names := []string{}
+	for _, r := range regions {
+		if string(r.Provider) != cloudProvider {
+			continue
+		}
+
+		if r.Region == regionName {
+			return r.RegionID, nil
+		}
+
+		names = append(names, r.Region)
+	}
+
+	return uuid.Nil, &util.SummaryWithDetailError{
+		Summary: fmt.Sprintf("Region %q of cloud provider %s is not found", regionName, cloudProvider),
+		Detail:  fmt.Sprintf("The available regions of %s are: %s.", cloudProvider, util.Join(names, ", ")),
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -31,18 +35,32 @@ var (
 
 // workspaceGroupResource is the resource implementation.
 type workspaceGroupResource struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspaceGroupResourceModel maps the resource schema data.
 type workspaceGroupResourceModel struct {
-	ID             types.String   `tfsdk:"id"`
-	Name           types.String   `tfsdk:"name"`
-	FirewallRanges []types.String `tfsdk:"firewall_ranges"`
-	CreatedAt      types.String   `tfsdk:"created_at"`
-	ExpiresAt      types.String   `tfsdk:"expires_at"`
-	RegionID       types.String   `tfsdk:"region_id"`
-	AdminPassword  types.String   `tfsdk:"admin_password"`
+	ID                            types.String               `tfsdk:"id"`
+	Name                          types.String               `tfsdk:"name"`
+	FirewallRanges                []types.String             `tfsdk:"firewall_ranges"`
+	CreatedAt                     types.String               `tfsdk:"created_at"`
+	ExpiresAt                     types.String               `tfsdk:"expires_at"`
+	TTL                           types.String               `tfsdk:"ttl"`
+	ExpiresAfterIdle              types.String               `tfsdk:"expires_after_idle"`
+	RegionID                      types.String               `tfsdk:"region_id"`
+	CloudProvider                 types.String               `tfsdk:"cloud_provider"`
+	RegionName                    types.String               `tfsdk:"region_name"`
+	AdminPassword                 types.String               `tfsdk:"admin_password"`
+	EncryptedAdminPassword        types.String               `tfsdk:"encrypted_admin_password"`
+	IgnoreUnmanagedFirewallRanges types.Bool                 `tfsdk:"ignore_unmanaged_firewall_ranges"`
+	AllowCurrentIP                types.Bool                 `tfsdk:"allow_current_ip"`
+	CurrentIPRange                types.String               `tfsdk:"current_ip_range"`
+	DeletionProtection            types.Bool                 `tfsdk:"deletion_protection"`
+	DestroyConfirmation           types.String               `tfsdk:"destroy_confirmation"`
+	WaitForCreation               types.Bool                 `tfsdk:"wait_for_creation"`
+	WaitForTermination            types.Bool                 `tfsdk:"wait_for_termination"`
+	UpdateWindow                  *updateWindowResourceModel `tfsdk:"update_window"`
+	Timeouts                      *util.Timeouts             `tfsdk:"timeouts"`
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -71,10 +89,10 @@ func (r *workspaceGroupResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:            true,
 				MarkdownDescription: "Name of the workspace group.",
 			},
-			"firewall_ranges": schema.ListAttribute{
+			"firewall_ranges": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Required:            true,
-				MarkdownDescription: "List of allowed CIDR ranges. An empty list blocks all inbound requests. For unrestricted traffic, use [\"0.0.0.0/0\"]. Note that updates to firewall ranges may take a brief moment to become effective.",
+				MarkdownDescription: "Set of allowed CIDR ranges. An empty set blocks all inbound requests. For unrestricted traffic, use [\"0.0.0.0/0\"]. The order of the ranges does not matter. Note that updates to firewall ranges may take a brief moment to become effective.",
 			},
 			"created_at": schema.StringAttribute{
 				PlanModifiers: []planmodifier.String{
@@ -85,20 +103,110 @@ func (r *workspaceGroupResource) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"expires_at": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: `The expiration timestamp of the workspace group. If not specified, the workspace group never expires. Upon expiration, the workspace group is terminated and all its data is lost. Set the expiration time as an RFC3339 UTC timestamp, e.g., "2221-01-02T15:04:05Z".`,
-				Validators:          []validator.String{util.NewTimeValidator()},
+				Computed:            true,
+				MarkdownDescription: `The expiration timestamp of the workspace group. If not specified, the workspace group never expires unless the ttl is specified. Upon expiration, the workspace group is terminated and all its data is lost. Set the expiration time as an RFC3339 UTC timestamp, e.g., "2221-01-02T15:04:05Z", or as a duration relative to the creation time, e.g., "720h". A duration is resolved to a timestamp on creation and kept in the state as is, so that it does not show a difference on every plan; changing it moves the expiration timestamp relative to the creation time.`,
+				Validators:          []validator.String{util.NewTimeOrDurationValidator()},
+			},
+			"ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: `The time to live of the workspace group as a duration, e.g., "4h" or "90m". On creation, the expiration timestamp is set to the creation time plus the ttl, so that ephemeral workspace groups, e.g., of CI pipelines, terminate even if destroy never runs. Changing the ttl moves the expiration timestamp relative to the creation time. Conflicts with expires_at.`,
+				Validators: []validator.String{
+					util.NewDurationValidator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("expires_at")),
+				},
+			},
+			"expires_after_idle": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: `The duration without Terraform applies after which the workspace group expires, e.g., "168h". Once less than half of this duration remains, the plan pushes the expiration timestamp forward to the current time plus this duration, so that a long-lived staging workspace group does not expire while it is in use, yet an abandoned one is terminated. Refreshing never changes the expiration timestamp. Conflicts with expires_at and ttl.`,
+				Validators: []validator.String{
+					util.NewDurationValidator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("expires_at"), path.MatchRoot("ttl")),
+				},
 			},
 			"region_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier of the region where the workspace group is to be created.",
-				Validators:          []validator.String{util.NewUUIDValidator()},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the region where the workspace group is to be created. Either the region ID or the cloud provider and the region name should be specified.",
+				Validators: []validator.String{
+					util.NewUUIDValidator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("region_name")),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The cloud provider of the region, one of '%s', '%s', or '%s'. Requires region_name.", management.AWS, management.GCP, management.Azure),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(management.AWS), string(management.GCP), string(management.Azure)),
+					stringvalidator.AlsoRequires(path.MatchRoot("region_name")),
+				},
+			},
+			"region_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the region as listed by the singlestoredb_regions data source, e.g., \"GS - US West 2 (Oregon) - aws-oregon-gs1\". The provider resolves the name to the region ID on plan, so that the region ID does not have to be looked up. Requires cloud_provider. Conflicts with region_id.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("cloud_provider")),
+				},
 			},
 			"admin_password": schema.StringAttribute{
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: `The admin SQL user password for the workspace group. If not provided, the server generates a strong password on creation and this attribute exposes it, unless the state_encryption_passphrase of the provider is set, so there is no need for a separate random password resource. A configured password takes precedence over the generated one and is applied on change. Removing the password from the configuration keeps the current one. Please note that updates to the admin password might take a brief moment to become effective.`,
+			},
+			"encrypted_admin_password": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: fmt.Sprintf("The admin password generated on creation, encrypted with the '%s' of the provider. If the passphrase is set, admin_password is left empty and the generated password is kept only here.", config.StateEncryptionPassphraseAttribute),
+			},
+			"ignore_unmanaged_firewall_ranges": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If true, only the declared firewall ranges are managed. Ranges added outside of Terraform are neither shown as drift nor removed on update; the declared ranges are merged with them instead.",
+			},
+			"allow_current_ip": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: fmt.Sprintf("If true, the public IP of the machine running Terraform is detected when the workspace group is created or updated and allowed in addition to the firewall ranges, e.g., for developer environments and CI runners with dynamic egress IPs. The previously allowed IP is replaced on the next update; plans without other changes do not detect the IP again. The IP is detected by the external service %s unless the %s environment variable specifies another service. Defaults to false.", config.CurrentIPServiceURL, config.EnvCurrentIPServiceURL),
+			},
+			"current_ip_range": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The range of the public IP that is allowed because of allow_current_ip, or null.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If true, destroying the workspace group fails. To delete a protected workspace group, set it to false and apply first. Defaults to false.",
+			},
+			"destroy_confirmation": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, planning to destroy the workspace group fails unless it equals the name of the workspace group. To delete the workspace group, set it to the name and apply first, e.g., so that destroying a shared production workspace group takes a deliberate change naming it. If not set, destroying is not restricted.",
+			},
+			"wait_for_creation": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				Sensitive:           true,
-				MarkdownDescription: `The admin SQL user password for the workspace group. If not provided, the server will automatically generate a secure password. Please note that updates to the admin password might take a brief moment to become effective.`,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, creating the workspace group waits until the workspace group is active. If false, creating returns once the Management API accepts the creation, e.g., to fan out many environments and poll their readiness separately, and refreshing tolerates the PENDING state. Defaults to true.",
 			},
+			"wait_for_termination": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, destroying the workspace group waits until the workspace group is terminated. If false, destroying returns once the Management API accepts the termination, e.g., to tear down large ephemeral environments quickly. Defaults to true.",
+			},
+			"update_window": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The weekly time period during which any updates to the workspace group occur. If not specified, the update window is not managed, e.g., so that the singlestoredb_workspace_group_update_window resource manages it instead.",
+				Attributes:          newUpdateWindowResourceSchemaAttributes(),
+			},
+			"timeouts": util.TimeoutsSchemaAttribute(config.WorkspaceGroupCreationTimeout, config.WorkspaceGroupCreationTimeout, config.WorkspaceGroupTerminationTimeout),
 		},
 	}
 }
@@ -112,12 +220,32 @@ func (r *workspaceGroupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	expiresAt := resolveExpiresAt(plan.ExpiresAt, time.Now().UTC())
+	if ttl, err := util.ParseDuration(plan.TTL.ValueString()); err == nil {
+		expiresAt = util.Ptr(time.Now().UTC().Add(ttl).Format(time.RFC3339))
+	}
+
+	if idle, err := util.ParseDuration(plan.ExpiresAfterIdle.ValueString()); err == nil {
+		expiresAt = util.Ptr(time.Now().UTC().Add(idle).Format(time.RFC3339))
+	}
+
+	if serr := resolveCurrentIPRange(ctx, &plan); serr != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_current_ip"),
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
 	workspaceGroupCreateResponse, err := r.PostV1WorkspaceGroupsWithResponse(ctx, management.PostV1WorkspaceGroupsJSONRequestBody{
 		AdminPassword:  util.MaybeString(plan.AdminPassword),
-		ExpiresAt:      util.MaybeString(plan.ExpiresAt),
-		FirewallRanges: util.StringFirewallRanges(plan.FirewallRanges),
+		ExpiresAt:      expiresAt,
+		FirewallRanges: withCurrentIPRange(util.StringFirewallRanges(plan.FirewallRanges), plan.CurrentIPRange),
 		Name:           plan.Name.ValueString(),
 		RegionID:       uuid.MustParse(plan.RegionID.ValueString()),
+		UpdateWindow:   toManagementUpdateWindow(plan.UpdateWindow),
 	})
 	if serr := util.StatusOK(workspaceGroupCreateResponse, err); serr != nil {
 		resp.Diagnostics.AddError(
@@ -129,7 +257,20 @@ func (r *workspaceGroupResource) Create(ctx context.Context, req resource.Create
 	}
 
 	id := workspaceGroupCreateResponse.JSON200.WorkspaceGroupID
-	wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id)
+	diags = setDeclaredFirewallRanges(ctx, resp.Private, withCurrentIPRange(util.StringFirewallRanges(plan.FirewallRanges), plan.CurrentIPRange))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var wg management.WorkspaceGroup
+	var werr *util.SummaryWithDetailError
+	if plan.WaitForCreation.ValueBool() {
+		wg, werr = waitStatusActive(ctx, r.ClientWithResponsesInterface, id, plan.Timeouts.CreateTimeout(config.WorkspaceGroupCreationTimeout))
+	} else {
+		wg, werr = getWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id) // Getting the workspace group once in whatever state it is.
+	}
+
 	if werr != nil {
 		resp.Diagnostics.AddError(
 			werr.Summary,
@@ -143,9 +284,25 @@ func (r *workspaceGroupResource) Create(ctx context.Context, req resource.Create
 		plan.AdminPassword.ValueString(),
 		util.Deref(workspaceGroupCreateResponse.JSON200.AdminPassword), // Either from input or output.
 	))
+	result = withDeclaredFirewallRanges(withoutCurrentIPRange(result, plan), plan.IgnoreUnmanagedFirewallRanges, plan.FirewallRanges)
+	result = withConfigOnlyAttributes(result, plan)
+	result = withDeclaredUpdateWindow(result, plan.UpdateWindow, wg.UpdateWindow)
+
+	var eerr error
+	if r.StateEncryptionPassphrase != "" && plan.AdminPassword.IsUnknown() {
+		result.EncryptedAdminPassword, eerr = encryptedStringValue(r.StateEncryptionPassphrase, result.AdminPassword.ValueString())
+		result.AdminPassword = types.StringValue("") // Kept only encrypted.
+	}
 
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+
+	if eerr != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to encrypt the admin password of workspace group %s", id),
+			fmt.Sprintf("The workspace group is created, yet its generated admin password is not kept in the state. Set admin_password to reset it.\n\nError: %s", eerr),
+		)
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -176,7 +333,8 @@ func (r *workspaceGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return // The resource got terminated externally, deleting it from the state file to recreate.
 	}
 
-	if workspaceGroup.JSON200.State != management.ACTIVE {
+	creating := workspaceGroup.JSON200.State == management.PENDING && !state.WaitForCreation.IsNull() && !state.WaitForCreation.ValueBool()
+	if workspaceGroup.JSON200.State != management.ACTIVE && !creating {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Workspace group %s state is %s while it should be %s", state.ID.ValueString(), workspaceGroup.JSON200.State, management.ACTIVE),
 			"An unexpected workspace group state.\n\n"+
@@ -186,7 +344,11 @@ func (r *workspaceGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return // A workspace group may be, e.g., PENDING during update windows when all the update activity is prohibited.
 	}
 
-	state = toWorkspaceGroupResourceModel(*workspaceGroup.JSON200, state.AdminPassword.ValueString())
+	state = withDeclaredUpdateWindow(withConfigOnlyAttributes(withDeclaredFirewallRanges(
+		withoutCurrentIPRange(toWorkspaceGroupResourceModel(*workspaceGroup.JSON200, state.AdminPassword.ValueString()), state),
+		state.IgnoreUnmanagedFirewallRanges,
+		state.FirewallRanges,
+	), state), state.UpdateWindow, workspaceGroup.JSON200.UpdateWindow)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -197,24 +359,27 @@ func (r *workspaceGroupResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *workspaceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state workspaceGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan workspaceGroupResourceModel
-	diags := req.Plan.Get(ctx, &plan)
+	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	id := uuid.MustParse(plan.ID.ValueString())
-	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
-		management.WorkspaceGroupUpdate{
-			AdminPassword:  util.MaybeString(plan.AdminPassword),
-			ExpiresAt:      util.MaybeString(plan.ExpiresAt),
-			Name:           util.MaybeString(plan.Name),
-			FirewallRanges: util.Ptr(util.StringFirewallRanges(plan.FirewallRanges)),
-		},
-	)
-	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
-		resp.Diagnostics.AddError(
+
+	defer lockFirewall(id.String())() // Firewall rule resources may update the same firewall ranges concurrently.
+
+	if serr := resolveCurrentIPRange(ctx, &plan); serr != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_current_ip"),
 			serr.Summary,
 			serr.Detail,
 		)
@@ -222,7 +387,99 @@ func (r *workspaceGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id)
+	firewallRanges := withCurrentIPRange(util.StringFirewallRanges(plan.FirewallRanges), plan.CurrentIPRange)
+	actualFirewallRanges := withCurrentIPRange(util.StringFirewallRanges(state.FirewallRanges), state.CurrentIPRange)
+	if plan.IgnoreUnmanagedFirewallRanges.ValueBool() {
+		workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+		if serr := util.StatusOK(workspaceGroup, err); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		previous, diags := previousFirewallRanges(ctx, req.Private, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		actualFirewallRanges = util.Deref(workspaceGroup.JSON200.FirewallRanges)
+		firewallRanges = mergeFirewallRanges(actualFirewallRanges, previous, firewallRanges)
+	}
+
+	expiresAt := util.MaybeString(plan.ExpiresAt)
+	if sameTime(plan.ExpiresAt, state.ExpiresAt) {
+		expiresAt = nil // Not changed.
+	}
+
+	if isDuration(plan.ExpiresAt) {
+		expiresAt = nil // Not moving the expiration timestamp while the duration stays the same.
+		createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+		if err == nil && !plan.ExpiresAt.Equal(state.ExpiresAt) {
+			expiresAt = resolveExpiresAt(plan.ExpiresAt, createdAt)
+		}
+	}
+
+	if idle, err := util.ParseDuration(plan.ExpiresAfterIdle.ValueString()); err == nil {
+		expiresAt = nil // Enough time remains.
+		if plan.ExpiresAt.IsUnknown() || !plan.ExpiresAfterIdle.Equal(state.ExpiresAfterIdle) {
+			expiresAt = util.Ptr(time.Now().UTC().Add(idle).Format(time.RFC3339))
+		}
+	}
+
+	adminPassword := util.MaybeString(plan.AdminPassword)
+	if plan.AdminPassword.Equal(state.AdminPassword) {
+		adminPassword = nil // Not resetting the password, e.g., if it was changed outside of Terraform.
+	}
+
+	update := management.WorkspaceGroupUpdate{
+		AdminPassword: adminPassword,
+		ExpiresAt:     expiresAt,
+	}
+	changed := adminPassword != nil || expiresAt != nil
+
+	if !plan.Name.Equal(state.Name) {
+		update.Name = util.MaybeString(plan.Name)
+		changed = true
+	}
+
+	if !sameFirewallRanges(util.FirewallRanges(&firewallRanges), util.FirewallRanges(&actualFirewallRanges)) {
+		update.FirewallRanges = util.Ptr(firewallRanges)
+		changed = true
+	}
+
+	if plan.UpdateWindow != nil && !sameUpdateWindow(plan.UpdateWindow, state.UpdateWindow) {
+		update.UpdateWindow = toManagementUpdateWindow(plan.UpdateWindow)
+		changed = true
+	}
+
+	diags = setDeclaredFirewallRanges(ctx, resp.Private, withCurrentIPRange(util.StringFirewallRanges(plan.FirewallRanges), plan.CurrentIPRange))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var wg management.WorkspaceGroup
+	var werr *util.SummaryWithDetailError
+	if changed {
+		workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, update)
+		if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		wg, werr = waitStatusActive(ctx, r.ClientWithResponsesInterface, id, plan.Timeouts.UpdateTimeout(config.WorkspaceGroupCreationTimeout))
+	} else {
+		wg, werr = getWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id) // Only the attributes unknown to the Management API changed.
+	}
+
 	if werr != nil {
 		resp.Diagnostics.AddError(
 			werr.Summary,
@@ -232,7 +489,20 @@ func (r *workspaceGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	result := toWorkspaceGroupResourceModel(wg, plan.AdminPassword.ValueString())
+	result, ignored := verifyUpdate(plan, withDeclaredUpdateWindow(withDeclaredFirewallRanges(
+		withoutCurrentIPRange(toWorkspaceGroupResourceModel(wg, plan.AdminPassword.ValueString()), plan),
+		plan.IgnoreUnmanagedFirewallRanges,
+		plan.FirewallRanges,
+	), plan.UpdateWindow, wg.UpdateWindow))
+	result = withConfigOnlyAttributes(result, plan)
+	if len(ignored) > 0 {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Workspace group %s did not apply all the requested changes", id),
+			fmt.Sprintf("The Management API accepted the update, but the following attributes did not change: %s. "+
+				"The next plan shows the difference. %s",
+				strings.Join(ignored, ", "), config.ContactSupportLaterErrorDetail),
+		)
+	}
 
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
@@ -250,11 +520,30 @@ func (r *workspaceGroupResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	workspaceGroupDeleteResponse, err := r.DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx,
-		uuid.MustParse(state.ID.ValueString()),
-		&management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams{Force: util.Ptr(true)}, // Deleting even if workspaces in the group.
-	)
-	if serr := util.StatusOK(workspaceGroupDeleteResponse, err); serr != nil {
+	id := uuid.MustParse(state.ID.ValueString())
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Cannot delete workspace group %s because deletion protection is enabled", id),
+			"To prevent accidental deletion of the workspace group and loss of data, the workspace group is protected. "+
+				"Set deletion_protection to false and apply before destroying the workspace group.",
+		)
+
+		return
+	}
+
+	if cerr := checkDestroyConfirmation(state); cerr != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destroy_confirmation"),
+			cerr.Summary,
+			cerr.Detail,
+		)
+
+		return
+	}
+
+	workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
 		resp.Diagnostics.AddError(
 			serr.Summary,
 			serr.Detail,
@@ -262,6 +551,37 @@ func (r *workspaceGroupResource) Delete(ctx context.Context, req resource.Delete
 
 		return
 	}
+
+	if workspaceGroup.JSON200 == nil {
+		return // Already deleted.
+	}
+
+	if !isTerminating(*workspaceGroup.JSON200) { // Otherwise, a previous interrupted delete already started the termination.
+		workspaceGroupDeleteResponse, err := r.DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+			&management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams{Force: util.Ptr(true)}, // Deleting even if workspaces in the group.
+		)
+		if serr := util.StatusOK(workspaceGroupDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+	}
+
+	if !state.WaitForTermination.ValueBool() {
+		return
+	}
+
+	if werr := waitStatusTerminated(ctx, r.ClientWithResponsesInterface, id, state.Timeouts.DeleteTimeout(config.WorkspaceGroupTerminationTimeout)); werr != nil {
+		resp.Diagnostics.AddError(
+			werr.Summary,
+			werr.Detail,
+		)
+
+		return
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -270,39 +590,186 @@ func (r *workspaceGroupResource) Configure(_ context.Context, req resource.Confi
 		return // Should not return an error for unknown reasons.
 	}
 
-	r.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	r.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 // ModifyPlan emits an error if a required yet immutable field changes or if incompatible state is set.
+// It also resolves the region name to the region ID and plans the expiration timestamp that the ttl implies.
 //
 // `RequiresReplace` is not used because deleting a workspace group results in the data loss.
 func (r *workspaceGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var state *workspaceGroupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || state == nil {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var plan *workspaceGroupResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || plan == nil {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !plan.RegionID.Equal(state.RegionID) {
+	if plan == nil { // Destroying.
+		if state == nil {
+			return
+		}
+
+		if cerr := checkDestroyConfirmation(*state); cerr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("destroy_confirmation"),
+				cerr.Summary,
+				cerr.Detail,
+			)
+		}
+
+		return
+	}
+
+	if plan.RegionID.IsUnknown() && !plan.RegionName.IsNull() && !plan.RegionName.IsUnknown() && !plan.CloudProvider.IsUnknown() {
+		regionID, serr := resolveRegionID(ctx, r.ProviderData, plan.CloudProvider.ValueString(), plan.RegionName.ValueString())
+		if serr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("region_name"),
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		plan.RegionID = util.UUIDStringValue(regionID)
+		diags = resp.Plan.SetAttribute(ctx, path.Root("region_id"), plan.RegionID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && !plan.RegionID.IsUnknown() && !plan.RegionID.Equal(state.RegionID) {
 		resp.Diagnostics.AddError("Cannot update workspace group region ID",
 			"To prevent accidental deletion of the workspace group and loss of data, updating the region ID is not permitted. "+
 				"Please explicitly delete the workspace group before changing its region ID.")
 
 		return
 	}
+
+	currentIPRange := types.StringNull()
+	if plan.AllowCurrentIP.ValueBool() {
+		currentIPRange = types.StringUnknown() // Detected at apply time.
+		if state != nil && state.AllowCurrentIP.ValueBool() && !state.CurrentIPRange.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+			currentIPRange = state.CurrentIPRange // Refreshing without changes does not detect the IP.
+		}
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("current_ip_range"), currentIPRange)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configExpiresAt types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("expires_at"), &configExpiresAt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !configExpiresAt.IsNull() {
+		return
+	}
+
+	expiresAt, known := plannedExpiresAt(*plan, state)
+	if !known {
+		return // Known after apply.
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)
+	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState results in Terraform managing the resource that was not previously managed.
+//
+// The update window is declared as a placeholder, so that Read populates it from the Management API
+// and the configuration generated on import, e.g., with terraform plan -generate-config-out, manages it.
 func (r *workspaceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root(config.IDAttribute), req, resp)
+
+	diags := resp.State.SetAttribute(ctx, path.Root("update_window"), &updateWindowResourceModel{
+		Hour: types.Int64Null(),
+		Day:  types.Int64Null(),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// isDuration reports whether the expiration timestamp is declared as a duration relative to the creation time.
+func isDuration(expiresAt types.String) bool {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false
+	}
+
+	_, err := util.ParseDuration(expiresAt.ValueString())
+
+	return err == nil
+}
+
+// resolveExpiresAt returns the expiration timestamp, resolving a duration relative to the time.
+func resolveExpiresAt(expiresAt types.String, from time.Time) *string {
+	if !isDuration(expiresAt) {
+		return util.MaybeString(expiresAt)
+	}
+
+	d, _ := util.ParseDuration(expiresAt.ValueString())
+
+	return util.Ptr(from.Add(d).UTC().Format(time.RFC3339))
+}
+
+// plannedExpiresAt returns the expiration timestamp of a workspace group that does not declare it explicitly.
+//
+// Without the ttl, the workspace group never expires. With the ttl, the timestamp is known after apply on creation,
+// stays the same while the ttl stays the same, and moves relative to the creation time if the ttl changes.
+// With the idle duration, the timestamp is planned to be pushed forward once less than half of the duration remains,
+// so that refreshing has no side effects and the plans of frequent runs stay empty.
+func plannedExpiresAt(plan workspaceGroupResourceModel, state *workspaceGroupResourceModel) (types.String, bool) {
+	if !plan.ExpiresAfterIdle.IsNull() {
+		if state == nil || !plan.ExpiresAfterIdle.Equal(state.ExpiresAfterIdle) {
+			return types.StringUnknown(), false
+		}
+
+		idle, err := util.ParseDuration(plan.ExpiresAfterIdle.ValueString())
+		if err != nil {
+			return types.StringUnknown(), false // The validator reports the error.
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+		if err == nil && time.Until(expiresAt) > idle/2 {
+			return state.ExpiresAt, true
+		}
+
+		return types.StringUnknown(), true // Pushed forward by the update.
+	}
+
+	if plan.TTL.IsNull() {
+		return types.StringNull(), true
+	}
+
+	if state == nil || plan.TTL.IsUnknown() {
+		return types.StringUnknown(), false
+	}
+
+	if plan.TTL.Equal(state.TTL) {
+		return state.ExpiresAt, true
+	}
+
+	ttl, err := util.ParseDuration(plan.TTL.ValueString())
+	if err != nil {
+		return types.StringUnknown(), false // The validator reports the error.
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+	if err != nil {
+		return types.StringUnknown(), false
+	}
+
+	return types.StringValue(createdAt.Add(ttl).UTC().Format(time.RFC3339)), true
 }
 
 func toWorkspaceGroupResourceModel(workspaceGroup management.WorkspaceGroup, adminPassword string) workspaceGroupResourceModel {
@@ -317,12 +784,78 @@ func toWorkspaceGroupResourceModel(workspaceGroup management.WorkspaceGroup, adm
 	}
 }
 
-func waitStatusActive(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceGroupID) (management.WorkspaceGroup, *util.SummaryWithDetailError) {
+// withDeclaredFirewallRanges narrows down the firewall ranges to the declared ones if unmanaged ranges are ignored.
+func withDeclaredFirewallRanges(result workspaceGroupResourceModel, ignoreUnmanaged types.Bool, declared []types.String) workspaceGroupResourceModel {
+	result.IgnoreUnmanagedFirewallRanges = types.BoolValue(ignoreUnmanaged.ValueBool()) // Null after import.
+	if ignoreUnmanaged.ValueBool() {
+		result.FirewallRanges = managedFirewallRanges(declared, result.FirewallRanges)
+	}
+
+	return result
+}
+
+// withDeclaredUpdateWindow sets the update window only if it is declared, so that an undeclared one is not managed.
+func withDeclaredUpdateWindow(result workspaceGroupResourceModel, declared *updateWindowResourceModel, actual *management.UpdateWindow) workspaceGroupResourceModel {
+	result.UpdateWindow = nil
+	if declared != nil {
+		result.UpdateWindow = toUpdateWindowResourceModel(actual)
+	}
+
+	return result
+}
+
+// withConfigOnlyAttributes copies the attributes that only affect the provider and are unknown to the Management API.
+// An expiration duration is copied too since the Management API knows only the resolved timestamp.
+func withConfigOnlyAttributes(result, source workspaceGroupResourceModel) workspaceGroupResourceModel {
+	result.TTL = source.TTL
+	result.CloudProvider = source.CloudProvider
+	result.RegionName = source.RegionName
+	result.ExpiresAfterIdle = source.ExpiresAfterIdle
+	if isDuration(source.ExpiresAt) {
+		result.ExpiresAt = source.ExpiresAt
+	}
+	result.DeletionProtection = types.BoolValue(source.DeletionProtection.ValueBool()) // Null after import.
+	result.DestroyConfirmation = source.DestroyConfirmation
+	result.Timeouts = source.Timeouts
+	result.WaitForCreation = types.BoolValue(source.WaitForCreation.IsNull() || source.WaitForCreation.ValueBool()) // Null after import.
+	result.WaitForTermination = types.BoolValue(source.WaitForTermination.IsNull() || source.WaitForTermination.ValueBool())
+	result.EncryptedAdminPassword = types.StringNull()
+	if !source.EncryptedAdminPassword.IsUnknown() {
+		result.EncryptedAdminPassword = source.EncryptedAdminPassword
+	}
+
+	return result
+}
+
+// checkDestroyConfirmation returns an error unless the destroy confirmation is either not set or equals the name.
+func checkDestroyConfirmation(state workspaceGroupResourceModel) *util.SummaryWithDetailError {
+	if state.DestroyConfirmation.IsNull() || state.DestroyConfirmation.Equal(state.Name) {
+		return nil
+	}
+
+	return &util.SummaryWithDetailError{
+		Summary: fmt.Sprintf("Cannot delete workspace group %s because destroy_confirmation does not match its name", state.ID.ValueString()),
+		Detail: "To prevent accidental deletion of the workspace group and loss of data, destroying requires a confirmation. " +
+			fmt.Sprintf("Set destroy_confirmation to %q and apply before destroying the workspace group.", state.Name.ValueString()),
+	}
+}
+
+// getWorkspaceGroup returns the workspace group in whatever state it is.
+func getWorkspaceGroup(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceGroupID) (management.WorkspaceGroup, *util.SummaryWithDetailError) {
+	workspaceGroup, err := c.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	if serr := util.StatusOK(workspaceGroup, err); serr != nil {
+		return management.WorkspaceGroup{}, serr
+	}
+
+	return *workspaceGroup.JSON200, nil
+}
+
+func waitStatusActive(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceGroupID, timeout time.Duration) (management.WorkspaceGroup, *util.SummaryWithDetailError) {
 	result := management.WorkspaceGroup{}
 
 	workspaceGroupStateHistory := make([]management.WorkspaceGroupState, 0, config.WorkspaceGroupConsistencyThreshold)
 
-	if err := retry.RetryContext(ctx, config.WorkspaceGroupCreationTimeout, func() *retry.RetryError {
+	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		workspaceGroup, err := c.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
 		if err != nil { // Not status code OK does not get here, not retrying for that reason.
 			ferr := fmt.Errorf("failed to get workspace group %s: %w", id, err)
@@ -330,6 +863,10 @@ func waitStatusActive(ctx context.Context, c management.ClientWithResponsesInter
 			return retry.NonRetryableError(ferr)
 		}
 
+		if code := workspaceGroup.StatusCode(); code == http.StatusUnauthorized {
+			return retry.NonRetryableError(util.StatusOK(workspaceGroup, nil)) // Retrying does not help with an expired API key.
+		}
+
 		if code := workspaceGroup.StatusCode(); code != http.StatusOK {
 			err := fmt.Errorf("failed to get workspace group %s: status code %s", id, http.StatusText(code))
 
@@ -370,3 +907,59 @@ func waitStatusActive(ctx context.Context, c management.ClientWithResponsesInter
 
 	return result, nil
 }
+
+// isTerminating returns true if the termination of the workspace group is either in progress or complete.
+func isTerminating(workspaceGroup management.WorkspaceGroup) bool {
+	return workspaceGroup.State == management.TERMINATED || workspaceGroup.TerminatedAt != nil
+}
+
+// waitStatusTerminated waits until the workspace group is either terminated or not found.
+func waitStatusTerminated(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceGroupID, timeout time.Duration) *util.SummaryWithDetailError {
+	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		workspaceGroup, err := c.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+		if err != nil {
+			ferr := fmt.Errorf("failed to get workspace group %s: %w", id, err)
+
+			return retry.NonRetryableError(ferr)
+		}
+
+		code := workspaceGroup.StatusCode()
+		if code == http.StatusNotFound {
+			return nil
+		}
+
+		if code == http.StatusUnauthorized {
+			return retry.NonRetryableError(util.StatusOK(workspaceGroup, nil)) // Retrying does not help with an expired API key.
+		}
+
+		if code != http.StatusOK {
+			err := fmt.Errorf("failed to get workspace group %s: status code %s", id, http.StatusText(code))
+
+			return retry.RetryableError(err)
+		}
+
+		if workspaceGroup.JSON200.State != management.TERMINATED {
+			err := fmt.Errorf("workspace group %s state is %s", id, workspaceGroup.JSON200.State)
+
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	}); err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to wait for a workspace group %s termination", id),
+			Detail:  fmt.Sprintf("Workspace group is not terminated yet: %s. Run destroy again to keep waiting for the termination. %s", err, config.ContactSupportLaterErrorDetail),
+		}
+	}
+
+	return nil
+}
+
+func encryptedStringValue(passphrase, value string) (types.String, error) {
+	encrypted, err := util.EncryptState(passphrase, value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(encrypted), nil
+}
@@ -2,10 +2,12 @@ package workspacegroups_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -164,6 +166,7 @@ func TestCRUDWorkspaceGroup(t *testing.T) {
 			},
 		))
 		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED // Ending the termination polling.
 	}
 
 	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
@@ -236,35 +239,1858 @@ func TestCRUDWorkspaceGroup(t *testing.T) {
 	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
 }
 
-func TestWorkspaceGroupResourceIntegration(t *testing.T) {
-	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
-		APIKey:             os.Getenv(config.EnvTestAPIKey),
-		WorkspaceGroupName: "this",
+func TestWorkspaceGroupIgnoresUnmanagedFirewallRanges(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	unmanagedFirewallRange := "10.0.0.0/8"
+	updatedFirewallRange := "1.1.1.1/32"
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	regionsHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/v1/regions" || r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsGetHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/") ||
+			r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsPostHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupCreate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.Equal(t, []string{config.TestInitialFirewallRange}, input.FirewallRanges)
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.FirewallRanges = util.Ptr(append(input.FirewallRanges, unmanagedFirewallRange)) // Added out-of-band.
+	}
+
+	workspaceGroupsPatchHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodPatch, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupUpdate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.Equal(t, []string{unmanagedFirewallRange, updatedFirewallRange}, util.Deref(input.FirewallRanges))
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.FirewallRanges = input.FirewallRanges
+	}
+
+	workspaceGroupsDeleteHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodDelete, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED // Ending the termination polling.
+	}
+
+	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
+		regionsHandler,
+		workspaceGroupsGetHandler,
+	}
+
+	writeHandlers := []func(w http.ResponseWriter, r *http.Request){
+		workspaceGroupsPostHandler,
+		workspaceGroupsPatchHandler,
+		workspaceGroupsDeleteHandler,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range readOnlyHandlers {
+			if h(w, r) {
+				return
+			}
+		}
+
+		require.NotEmpty(t, writeHandlers, "already executed all the expected mutating REST calls")
+
+		h := writeHandlers[0]
+
+		h(w, r)
+
+		writeHandlers = writeHandlers[1:]
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				Config: examples.WorkspaceGroupsResource,
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("ignore_unmanaged_firewall_ranges", cty.BoolVal(true)).
+					String(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group.this", config.IDAttribute),
-					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", config.TestInitialWorkspaceGroupName),
-					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", config.TestInitialAdminPassword),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "ignore_unmanaged_firewall_ranges", "true"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.0", config.TestInitialFirewallRange),
 				),
 			},
 			{
 				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
-					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
-					WithWorkspaceGroupResource("this")("admin_password", cty.StringVal(updatedAdminPassword)).
-					WithWorkspaceGroupResource("this")("firewall_ranges", cty.ListValEmpty(cty.String)).
-					String(), // Not testing updating expires at because of the limitations of testutil.IntegrationTest that ensures garbage collection.
+					WithWorkspaceGroupResource("this")("ignore_unmanaged_firewall_ranges", cty.BoolVal(true)).
+					WithWorkspaceGroupResource("this")("firewall_ranges", cty.ListVal([]cty.Value{cty.StringVal(updatedFirewallRange)})).
+					String(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group.this", config.IDAttribute),
-					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", updatedWorkspaceGroupName),
-					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", updatedAdminPassword),
-					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "0"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.0", updatedFirewallRange),
+				),
+			},
+		},
+	})
+
+	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
+}
+
+func TestWorkspaceGroupKeepsUnmanagedFirewallRangesWhenStartsIgnoring(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	unmanagedFirewallRange := "10.0.0.0/8"
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	patched := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			workspaceGroup.FirewallRanges = util.Ptr(input.FirewallRanges)
+
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups/"+workspaceGroupID.String() && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups/"+workspaceGroupID.String() && r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, []string{unmanagedFirewallRange, config.TestInitialFirewallRange}, util.Deref(input.FirewallRanges),
+				"should keep the range added out-of-band while the unmanaged ranges were not ignored yet")
+			workspaceGroup.FirewallRanges = input.FirewallRanges
+			patched = true
+
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups/"+workspaceGroupID.String() && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupsResource,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+			},
+			{
+				PreConfig: func() {
+					workspaceGroup.FirewallRanges = util.Ptr(append(util.Deref(workspaceGroup.FirewallRanges), unmanagedFirewallRange)) // Added out-of-band.
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("ignore_unmanaged_firewall_ranges", cty.BoolVal(true)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "ignore_unmanaged_firewall_ranges", "true"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.0", config.TestInitialFirewallRange),
 				),
 			},
 		},
 	})
+
+	require.True(t, patched, "should flip ignore_unmanaged_firewall_ranges in place")
+}
+
+func TestWorkspaceGroupKeepsIgnoredUpdatesInPlan(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	regionsHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/v1/regions" || r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsGetHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/") ||
+			r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsPostHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupCreate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.Equal(t, []string{config.TestInitialFirewallRange}, input.FirewallRanges)
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.FirewallRanges = util.Ptr(input.FirewallRanges)
+	}
+
+	workspaceGroupsPatchHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodPatch, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupUpdate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.Equal(t, updatedWorkspaceGroupName, util.Deref(input.Name))
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		// Accepting the update without applying it.
+	}
+
+	workspaceGroupsDeleteHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodDelete, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED // Ending the termination polling.
+	}
+
+	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
+		regionsHandler,
+		workspaceGroupsGetHandler,
+	}
+
+	writeHandlers := []func(w http.ResponseWriter, r *http.Request){
+		workspaceGroupsPostHandler,
+		workspaceGroupsPatchHandler,
+		workspaceGroupsDeleteHandler,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range readOnlyHandlers {
+			if h(w, r) {
+				return
+			}
+		}
+
+		require.NotEmpty(t, writeHandlers, "already executed all the expected mutating REST calls")
+
+		h := writeHandlers[0]
+
+		h(w, r)
+
+		writeHandlers = writeHandlers[1:]
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupsResource,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", config.TestInitialWorkspaceGroupName),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
+					String(),
+				Check:              resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", updatedWorkspaceGroupName),
+				ExpectNonEmptyPlan: true, // The refresh reveals that the name did not change.
+			},
+		},
+	})
+
+	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
+}
+
+func TestWorkspaceGroupResourceIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "this",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupsResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group.this", config.IDAttribute),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", config.TestInitialWorkspaceGroupName),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", config.TestInitialAdminPassword),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.0", config.TestInitialFirewallRange),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
+					WithWorkspaceGroupResource("this")("admin_password", cty.StringVal(updatedAdminPassword)).
+					WithWorkspaceGroupResource("this")("firewall_ranges", cty.ListValEmpty(cty.String)).
+					String(), // Not testing updating expires at because of the limitations of testutil.IntegrationTest that ensures garbage collection.
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group.this", config.IDAttribute),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", updatedWorkspaceGroupName),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", updatedAdminPassword),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceGroupDeleteWaitsForInterruptedTermination(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	regionsHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/v1/regions" || r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsGetHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/") ||
+			r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsPostHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
+		regionsHandler,
+		workspaceGroupsGetHandler,
+	}
+
+	writeHandlers := []func(w http.ResponseWriter, r *http.Request){
+		workspaceGroupsPostHandler, // No delete call since the termination is already in progress.
+	}
+
+	terminatingPolls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range readOnlyHandlers {
+			if h(w, r) {
+				if workspaceGroup.TerminatedAt != nil {
+					terminatingPolls++
+				}
+
+				if terminatingPolls == 2 {
+					workspaceGroup.State = management.TERMINATED // Completing the termination after the refresh.
+				}
+
+				return
+			}
+		}
+
+		require.NotEmpty(t, writeHandlers, "already executed all the expected mutating REST calls")
+
+		h := writeHandlers[0]
+
+		h(w, r)
+
+		writeHandlers = writeHandlers[1:]
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupsResource,
+			},
+			{
+				PreConfig: func() {
+					// Simulating a delete that got interrupted after the termination had started.
+					workspaceGroup.State = management.PENDING
+					workspaceGroup.TerminatedAt = util.Ptr(time.Now().UTC().Format(time.RFC3339))
+				},
+				Config:  examples.WorkspaceGroupsResource,
+				Destroy: true,
+			},
+		},
+	})
+
+	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupTTL(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	createdAt := time.Now().UTC()
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        createdAt.Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	updatedExpiresAt := createdAt.Add(8 * time.Hour).Format(time.RFC3339)
+
+	regionsHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/v1/regions" || r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsGetHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/") ||
+			r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsPostHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupCreate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.NotNil(t, input.ExpiresAt, "the ttl should set the expiration")
+		expiresAt, err := time.Parse(time.RFC3339, *input.ExpiresAt)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(4*time.Hour), expiresAt, time.Minute)
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.ExpiresAt = input.ExpiresAt
+	}
+
+	workspaceGroupsPatchHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodPatch, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var input management.WorkspaceGroupUpdate
+		require.NoError(t, json.Unmarshal(body, &input))
+		require.Equal(t, updatedExpiresAt, util.Deref(input.ExpiresAt), "the ttl should be relative to the creation time")
+
+		w.Header().Add("Content-Type", "json")
+		_, err = w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.ExpiresAt = input.ExpiresAt
+	}
+
+	workspaceGroupsDeleteHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodDelete, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED
+	}
+
+	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
+		regionsHandler,
+		workspaceGroupsGetHandler,
+	}
+
+	writeHandlers := []func(w http.ResponseWriter, r *http.Request){
+		workspaceGroupsPostHandler,
+		workspaceGroupsPatchHandler,
+		workspaceGroupsDeleteHandler,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range readOnlyHandlers {
+			if h(w, r) {
+				return
+			}
+		}
+
+		require.NotEmpty(t, writeHandlers, "already executed all the expected mutating REST calls")
+
+		h := writeHandlers[0]
+
+		h(w, r)
+
+		writeHandlers = writeHandlers[1:]
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("expires_at", cty.NullVal(cty.String)).
+					WithWorkspaceGroupResource("this")("ttl", cty.StringVal("4h")).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "ttl", "4h"),
+					resource.TestCheckResourceAttrSet("singlestoredb_workspace_group.this", "expires_at"),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("expires_at", cty.NullVal(cty.String)).
+					WithWorkspaceGroupResource("this")("ttl", cty.StringVal("8h")).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "ttl", "8h"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_at", updatedExpiresAt),
+				),
+			},
+		},
+	})
+
+	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
+}
+
+func TestWorkspaceGroupDeletionProtection(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	regionsHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/v1/regions" || r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(regions))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsGetHandler := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/") ||
+			r.Method != http.MethodGet {
+			return false
+		}
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspaceGroup))
+		require.NoError(t, err)
+
+		return true
+	}
+
+	workspaceGroupsPostHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/workspaceGroups", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	workspaceGroupsPatchHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodPatch, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	workspaceGroupsDeleteHandler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		require.Equal(t, http.MethodDelete, r.Method)
+
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(
+			struct {
+				WorkspaceGroupID uuid.UUID
+			}{
+				WorkspaceGroupID: workspaceGroupID,
+			},
+		))
+		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED
+	}
+
+	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
+		regionsHandler,
+		workspaceGroupsGetHandler,
+	}
+
+	writeHandlers := []func(w http.ResponseWriter, r *http.Request){
+		workspaceGroupsPostHandler,
+		workspaceGroupsPatchHandler, // Turning the protection off.
+		workspaceGroupsDeleteHandler,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range readOnlyHandlers {
+			if h(w, r) {
+				return
+			}
+		}
+
+		require.NotEmpty(t, writeHandlers, "already executed all the expected mutating REST calls")
+
+		h := writeHandlers[0]
+
+		h(w, r)
+
+		writeHandlers = writeHandlers[1:]
+	}))
+	t.Cleanup(server.Close)
+
+	protected := testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+		WithWorkspaceGroupResource("this")("deletion_protection", cty.True).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: protected,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "deletion_protection", "true"),
+			},
+			{
+				Config:      protected,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion protection is enabled"),
+			},
+			{
+				Config: examples.WorkspaceGroupsResource,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "deletion_protection", "false"),
+			},
+		},
+	})
+
+	require.Empty(t, writeHandlers, "all the mutating REST calls should have been called, but %d is left not called yet", len(writeHandlers))
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupDestroyConfirmation(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	deletes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodPatch:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			deletes++
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	unconfirmed := testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+		WithWorkspaceGroupResource("this")("destroy_confirmation", cty.StringVal("keep")).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: unconfirmed,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "destroy_confirmation", "keep"),
+			},
+			{
+				Config:      unconfirmed,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("destroy_confirmation does not match its name"),
+			},
+			{
+				PreConfig: func() {
+					require.Zero(t, deletes, "should fail on plan without deleting")
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("destroy_confirmation", cty.StringVal(config.TestInitialWorkspaceGroupName)).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "destroy_confirmation", config.TestInitialWorkspaceGroupName),
+			},
+		},
+	})
+
+	require.Equal(t, 1, deletes)
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupUpdateWindow(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.NotNil(t, input.UpdateWindow, "should set the update window at creation")
+			workspaceGroup.UpdateWindow = input.UpdateWindow
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			if input.UpdateWindow != nil {
+				workspaceGroup.UpdateWindow = input.UpdateWindow
+			}
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	withUpdateWindow := func(hour, day int64) string {
+		return testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+			WithWorkspaceGroupResource("this")("update_window", cty.ObjectVal(map[string]cty.Value{
+			"hour": cty.NumberIntVal(hour),
+			"day":  cty.NumberIntVal(day),
+		})).
+			String()
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      withUpdateWindow(24, 1),
+				ExpectError: regexp.MustCompile(`update_window.hour`),
+			},
+			{
+				Config:      withUpdateWindow(3, 7),
+				ExpectError: regexp.MustCompile(`update_window.day`),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("update_window", cty.ObjectVal(map[string]cty.Value{
+					"hour": cty.NumberIntVal(3),
+				})).
+					String(),
+				ExpectError: regexp.MustCompile(`day`), // Both attributes are required.
+			},
+			{
+				Config: withUpdateWindow(3, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "update_window.hour", "3"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "update_window.day", "1"),
+				),
+			},
+			{
+				Config: withUpdateWindow(22, 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "update_window.hour", "22"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "update_window.day", "6"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_workspace_group.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password"}, // Not returned by the Management API.
+			},
+			{
+				Config: examples.WorkspaceGroupsResource, // Unmanaged from now on.
+				Check:  resource.TestCheckNoResourceAttr("singlestoredb_workspace_group.this", "update_window.hour"),
+			},
+		},
+	})
+
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupGeneratedAdminPassword(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	generatedAdminPassword := "generatedBAR12$"
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.AdminPassword, "should let the server generate the password")
+			_, err = w.Write(testutil.MustJSON(
+				struct {
+					AdminPassword    string `json:"adminPassword"`
+					WorkspaceGroupID uuid.UUID
+				}{
+					AdminPassword:    generatedAdminPassword,
+					WorkspaceGroupID: workspaceGroupID,
+				},
+			))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.AdminPassword, "should not reset the password on unrelated updates")
+			require.Nil(t, input.FirewallRanges, "should patch the changed attributes only")
+			require.Nil(t, input.ExpiresAt, "should patch the changed attributes only")
+			workspaceGroup.Name = util.Deref(input.Name)
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	withoutAdminPassword := regexp.MustCompile(`(?m)^\s*admin_password\s*=.*\n`).ReplaceAllString(examples.WorkspaceGroupsResource, "")
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: withoutAdminPassword,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", generatedAdminPassword),
+			},
+			{
+				Config: testutil.UpdatableConfig(withoutAdminPassword).
+					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
+					String(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", updatedWorkspaceGroupName),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", generatedAdminPassword),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupEncryptedAdminPassword(t *testing.T) {
+	passphrase := "correct horse battery staple"
+	t.Setenv(config.EnvStateEncryptionPassphrase, passphrase)
+
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	generatedAdminPassword := "generatedBAR12$"
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.AdminPassword, "should let the server generate the password")
+			_, err = w.Write(testutil.MustJSON(
+				struct {
+					AdminPassword    string `json:"adminPassword"`
+					WorkspaceGroupID uuid.UUID
+				}{
+					AdminPassword:    generatedAdminPassword,
+					WorkspaceGroupID: workspaceGroupID,
+				},
+			))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.AdminPassword, "should not reset the password on unrelated updates")
+			workspaceGroup.Name = util.Deref(input.Name)
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	isEncryptedAdminPassword := func(value string) error {
+		decrypted, err := util.DecryptState(passphrase, value)
+		if err != nil {
+			return err
+		}
+
+		if decrypted != generatedAdminPassword {
+			return fmt.Errorf("the decrypted admin password is %q while it should be %q", decrypted, generatedAdminPassword)
+		}
+
+		return nil
+	}
+
+	withoutAdminPassword := regexp.MustCompile(`(?m)^\s*admin_password\s*=.*\n`).ReplaceAllString(examples.WorkspaceGroupsResource, "")
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: withoutAdminPassword,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", ""),
+					resource.TestCheckResourceAttrWith("singlestoredb_workspace_group.this", "encrypted_admin_password", isEncryptedAdminPassword),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(withoutAdminPassword).
+					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
+					String(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "name", updatedWorkspaceGroupName),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "admin_password", ""),
+					resource.TestCheckResourceAttrWith("singlestoredb_workspace_group.this", "encrypted_admin_password", isEncryptedAdminPassword),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupExpiresAtDuration(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        createdAt.Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			expiresAt, err := time.Parse(time.RFC3339, util.Deref(input.ExpiresAt))
+			require.NoError(t, err, "should resolve the duration to a timestamp")
+			require.WithinDuration(t, time.Now().Add(720*time.Hour), expiresAt, time.Minute)
+			workspaceGroup.ExpiresAt = input.ExpiresAt
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			patches++
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, createdAt.Add(1440*time.Hour).Format(time.RFC3339), util.Deref(input.ExpiresAt), "should move relative to the creation time")
+			workspaceGroup.ExpiresAt = input.ExpiresAt
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	withExpiresAt := func(expiresAt string) string {
+		return testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+			WithWorkspaceGroupResource("this")("expires_at", cty.StringVal(expiresAt)).
+			String()
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      withExpiresAt("next month"),
+				ExpectError: regexp.MustCompile(`720h`),
+			},
+			{
+				Config: withExpiresAt("720h"),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_at", "720h"),
+			},
+			{
+				Config:   withExpiresAt("720h"),
+				PlanOnly: true, // No perpetual difference with the resolved timestamp.
+			},
+			{
+				Config: withExpiresAt("1440h"),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_at", "1440h"),
+			},
+		},
+	})
+
+	require.Equal(t, 1, patches)
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupExpiresAfterIdle(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	requireExtended := func(expiresAt *string, idle time.Duration) {
+		result, err := time.Parse(time.RFC3339, util.Deref(expiresAt))
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(idle), result, time.Minute)
+	}
+
+	extensions := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			requireExtended(input.ExpiresAt, 168*time.Hour)
+			workspaceGroup.ExpiresAt = input.ExpiresAt
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			if input.ExpiresAt != nil {
+				extensions++
+				workspaceGroup.ExpiresAt = input.ExpiresAt
+			}
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	withoutExpiresAt := regexp.MustCompile(`(?m)^\s*expires_at\s*=.*\n`).ReplaceAllString(examples.WorkspaceGroupsResource, "")
+	withIdle := func(idle string) string {
+		return testutil.UpdatableConfig(withoutExpiresAt).
+			WithWorkspaceGroupResource("this")("expires_after_idle", cty.StringVal(idle)).
+			String()
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("expires_after_idle", cty.StringVal("168h")).
+					String(),
+				ExpectError: regexp.MustCompile(`expires_at`),
+			},
+			{
+				Config: withIdle("168h"),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_after_idle", "168h"),
+			},
+			{
+				PreConfig: func() {
+					require.Zero(t, extensions, "should not push the expiration forward on refresh")
+					workspaceGroup.ExpiresAt = util.Ptr(time.Now().UTC().Add(time.Hour).Format(time.RFC3339)) // Less than half remains.
+				},
+				Config: withIdle("168h"),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_after_idle", "168h"),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, 1, extensions, "should push the expiration forward on apply")
+					requireExtended(workspaceGroup.ExpiresAt, 168*time.Hour)
+				},
+				Config: withIdle("336h"),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "expires_after_idle", "336h"),
+			},
+		},
+	})
+
+	requireExtended(workspaceGroup.ExpiresAt, 336*time.Hour)
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupFirewallRangesOrder(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	declaredFirewallRanges := []string{"10.0.0.0/8", "127.0.0.1/32"}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{declaredFirewallRanges[1], declaredFirewallRanges[0]}), // Reordered by the API.
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path) // Reordering should not result in an update.
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	declared := testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+		WithWorkspaceGroupResource("this")("firewall_ranges", cty.ListVal([]cty.Value{
+		cty.StringVal(declaredFirewallRanges[0]),
+		cty.StringVal(declaredFirewallRanges[1]),
+	})).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: declared,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("singlestoredb_workspace_group.this", "firewall_ranges.*", declaredFirewallRanges[0]),
+					resource.TestCheckTypeSetElemAttr("singlestoredb_workspace_group.this", "firewall_ranges.*", declaredFirewallRanges[1]),
+				),
+			},
+			{
+				Config:   declared,
+				PlanOnly: true,
+			},
+		},
+	})
+
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupAllowCurrentIP(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+	initialIP := "203.0.113.7"
+	updatedIP := "203.0.113.8"
+	currentIP := initialIP
+
+	detections := 0
+	ipService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		detections++
+		_, err := w.Write([]byte(currentIP + "\n"))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(ipService.Close)
+	t.Setenv(config.EnvCurrentIPServiceURL, ipService.URL)
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, []string{config.TestInitialFirewallRange, initialIP + "/32"}, input.FirewallRanges)
+			workspaceGroup.FirewallRanges = util.Ptr(input.FirewallRanges)
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, []string{config.TestInitialFirewallRange, updatedIP + "/32"}, util.Deref(input.FirewallRanges), "should replace the previous IP")
+			workspaceGroup.FirewallRanges = input.FirewallRanges
+			workspaceGroup.Name = util.Deref(input.Name)
+			patches++
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	allowed := testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+		WithWorkspaceGroupResource("this")("allow_current_ip", cty.BoolVal(true)).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: allowed,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "current_ip_range", initialIP+"/32"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("singlestoredb_workspace_group.this", "firewall_ranges.*", config.TestInitialFirewallRange),
+				),
+			},
+			{
+				Config:   allowed,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					currentIP = updatedIP
+				},
+				Config:   allowed,
+				PlanOnly: true, // Plans without changes do not detect the IP.
+			},
+			{
+				Config: testutil.UpdatableConfig(allowed).
+					WithWorkspaceGroupResource("this")("name", cty.StringVal(updatedWorkspaceGroupName)).
+					String(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "current_ip_range", updatedIP+"/32"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "firewall_ranges.#", "1"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, 1, patches)
+	require.Equal(t, 2, detections, "should detect the IP on creation and on the update only")
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
+}
+
+func TestWorkspaceGroupDoesNotWaitForTermination(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			require.False(t, deleted, "should not poll the termination")
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			deleted = true // The state stays as is, the termination is in progress.
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("wait_for_termination", cty.False).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "wait_for_termination", "false"),
+			},
+		},
+	})
+
+	require.True(t, deleted)
+}
+
+func TestWorkspaceGroupRegionName(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+		{
+			RegionID: uuid.MustParse("4ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "US East 1 (N. Virginia)",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroupID := uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, regions[1].RegionID, input.RegionID, "should resolve the region name")
+			workspaceGroup.RegionID = input.RegionID
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		case r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	byName := func(cloudProvider, regionName string) string {
+		return testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+			WithWorkspaceGroupResource("this")("region_id", cty.NullVal(cty.String)).
+			WithWorkspaceGroupResource("this")("cloud_provider", cty.StringVal(cloudProvider)).
+			WithWorkspaceGroupResource("this")("region_name", cty.StringVal(regionName)).
+			String()
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      byName(string(management.GCP), regions[1].Region),
+				ExpectError: regexp.MustCompile("is not found"),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupsResource).
+					WithWorkspaceGroupResource("this")("region_name", cty.StringVal(regions[1].Region)).
+					WithWorkspaceGroupResource("this")("cloud_provider", cty.StringVal(string(management.AWS))).
+					String(),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: byName(string(management.AWS), regions[1].Region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "region_id", regions[1].RegionID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.this", "region_name", regions[1].Region),
+				),
+			},
+			{
+				Config:   byName(string(management.AWS), regions[1].Region),
+				PlanOnly: true,
+			},
+			{
+				Config:      byName(string(management.AWS), regions[0].Region),
+				ExpectError: regexp.MustCompile("Cannot update workspace group region ID"),
+			},
+		},
+	})
+
+	require.Equal(t, management.TERMINATED, workspaceGroup.State)
 }
@@ -0,0 +1,529 @@
+package workspacegroups
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceSetName = "workspace_group_set"
+)
+
+var _ resource.ResourceWithConfigure = &setResource{}
+
+// setResource is the resource implementation.
+type setResource struct {
+	util.ProviderData
+}
+
+// setResourceModel maps the resource schema data.
+type setResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	NamePrefix      types.String   `tfsdk:"name_prefix"`
+	RegionIDs       []types.String `tfsdk:"region_ids"`
+	FirewallRanges  []types.String `tfsdk:"firewall_ranges"`
+	AdminPassword   types.String   `tfsdk:"admin_password"`
+	ExpiresAt       types.String   `tfsdk:"expires_at"`
+	WorkspaceGroups types.Map      `tfsdk:"workspace_groups"`
+}
+
+// setMemberModel is a workspace group of a set, keyed by its region ID in the workspace_groups map.
+type setMemberModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+var setMemberType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	config.IDAttribute: types.StringType,
+	"name":             types.StringType,
+	"admin_password":   types.StringType,
+	"created_at":       types.StringType,
+}}
+
+// NewResourceSet is a helper function to simplify the provider implementation.
+func NewResourceSet() resource.Resource {
+	return &setResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *setResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceSetName)
+}
+
+// Schema defines the schema for the resource.
+func (r *setResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a set of workspace groups that share the same specification, one in each of the regions, e.g., for a multi-region active/active footprint. Each workspace group is named as the name prefix followed by a dash and the code of its region, e.g., orders-aws-oregon-gs1. The workspace groups are created, updated, and terminated concurrently, so adding a region waits for its workspace group only. Removing a region terminates its workspace group.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the set, the same as the name prefix.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The prefix of the workspace group names. Changing it renames the workspace groups.",
+			},
+			"region_ids": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the regions to create a workspace group in, one per region.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(util.NewUUIDValidator()),
+				},
+			},
+			"firewall_ranges": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Set of allowed CIDR ranges of every workspace group. An empty set blocks all inbound requests. For unrestricted traffic, use [\"0.0.0.0/0\"].",
+			},
+			"admin_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The admin SQL user password of every workspace group. If not provided, the server generates a strong password for each workspace group, see workspace_groups.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: `The expiration timestamp of every workspace group as an RFC3339 UTC timestamp, e.g., "2221-01-02T15:04:05Z". If not specified, the workspace groups never expire.`,
+				Validators:          []validator.String{util.NewTimeValidator()},
+			},
+			"workspace_groups": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The workspace groups of the set by region ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						config.IDAttribute: schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workspace group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workspace group.",
+						},
+						"admin_password": schema.StringAttribute{
+							Computed:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The admin SQL user password of the workspace group, either admin_password or the one that the server generated.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the workspace group was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+//
+// If some workspace groups fail, the created ones are kept in the state, which Terraform marks as tainted.
+func (r *setResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan setResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := map[string]setMemberModel{}
+	serr := r.createMembers(ctx, plan, sortedStrings(util.Map(plan.RegionIDs, util.ToString)), members)
+
+	r.setState(ctx, plan, members, serr, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *setResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state setResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := setMembers(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionIDs := sortedKeys(members)
+	workspaceGroups := make([]*management.WorkspaceGroup, len(regionIDs))
+	serr := util.InParallel(len(regionIDs), config.WorkspaceGroupSetConcurrency, func(i int) *util.SummaryWithDetailError {
+		workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx,
+			uuid.MustParse(members[regionIDs[i]].ID.ValueString()),
+			&management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{},
+		)
+		if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
+			return serr
+		}
+
+		workspaceGroups[i] = workspaceGroup.JSON200
+
+		return nil
+	})
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := state
+	for i, regionID := range regionIDs {
+		wg := workspaceGroups[i]
+		if wg == nil || wg.State == management.TERMINATED {
+			delete(members, regionID) // Terminated externally, e.g., expired, so that the next apply recreates it.
+
+			continue
+		}
+
+		members[regionID] = toSetMemberModel(*wg, members[regionID].AdminPassword.ValueString())
+		if !sameFirewallRanges(util.FirewallRanges(wg.FirewallRanges), state.FirewallRanges) {
+			result.FirewallRanges = util.FirewallRanges(wg.FirewallRanges) // Showing the drift of any workspace group.
+		}
+	}
+
+	if len(members) == 0 {
+		resp.State.RemoveResource(ctx)
+
+		return // All the workspace groups got terminated externally, deleting the set from the state file to recreate.
+	}
+
+	result, diags = toSetResourceModel(ctx, result, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// The workspace groups of the removed regions are terminated first, then the remaining ones are updated,
+// and finally the ones of the added regions are created.
+func (r *setResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state setResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan setResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := setMembers(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := util.Map(plan.RegionIDs, util.ToString)
+	removed := []string{}
+	kept := []string{}
+	for _, regionID := range sortedKeys(members) {
+		if util.Any(planned, regionID) {
+			kept = append(kept, regionID)
+		} else {
+			removed = append(removed, regionID)
+		}
+	}
+
+	added := []string{}
+	for _, regionID := range sortedStrings(planned) {
+		if _, ok := members[regionID]; !ok {
+			added = append(added, regionID)
+		}
+	}
+
+	serr := r.deleteMembers(ctx, removed, members)
+	if serr == nil {
+		serr = r.updateMembers(ctx, state, plan, kept, members)
+	}
+
+	if serr == nil {
+		serr = r.createMembers(ctx, plan, added, members)
+	}
+
+	r.setState(ctx, plan, members, serr, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *setResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state setResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := setMembers(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serr := r.deleteMembers(ctx, sortedKeys(members), members); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *setResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// setState sets the state to the workspace groups that exist, also if an operation failed, so that none of them is lost.
+func (r *setResource) setState(ctx context.Context, plan setResourceModel, members map[string]setMemberModel, serr *util.SummaryWithDetailError, state *tfsdk.State, diagnostics *diag.Diagnostics) {
+	if len(members) > 0 {
+		result, diags := toSetResourceModel(ctx, plan, members)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return
+		}
+
+		diags = state.Set(ctx, &result)
+		diagnostics.Append(diags...)
+	}
+
+	if serr != nil {
+		diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+	}
+}
+
+// createMembers creates the workspace groups in the regions concurrently and adds the ones that got active to the members.
+func (r *setResource) createMembers(ctx context.Context, plan setResourceModel, regionIDs []string, members map[string]setMemberModel) *util.SummaryWithDetailError {
+	mu := sync.Mutex{}
+
+	return util.InParallel(len(regionIDs), config.WorkspaceGroupSetConcurrency, func(i int) *util.SummaryWithDetailError {
+		name, serr := setMemberName(ctx, r.ProviderData, plan.NamePrefix.ValueString(), regionIDs[i])
+		if serr != nil {
+			return serr
+		}
+
+		workspaceGroupCreateResponse, err := r.PostV1WorkspaceGroupsWithResponse(ctx, management.PostV1WorkspaceGroupsJSONRequestBody{
+			AdminPassword:  util.MaybeString(plan.AdminPassword),
+			ExpiresAt:      util.MaybeString(plan.ExpiresAt),
+			FirewallRanges: util.StringFirewallRanges(plan.FirewallRanges),
+			Name:           name,
+			RegionID:       uuid.MustParse(regionIDs[i]),
+		})
+		if serr := util.StatusOK(workspaceGroupCreateResponse, err); serr != nil {
+			return serr
+		}
+
+		wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, workspaceGroupCreateResponse.JSON200.WorkspaceGroupID, config.WorkspaceGroupCreationTimeout)
+		if werr != nil {
+			return werr
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		members[regionIDs[i]] = toSetMemberModel(wg, util.FirstNotEmpty(
+			plan.AdminPassword.ValueString(),
+			util.Deref(workspaceGroupCreateResponse.JSON200.AdminPassword), // Either from input or output.
+		))
+
+		return nil
+	})
+}
+
+// updateMembers applies the changes of the shared specification to the workspace groups in the regions concurrently.
+func (r *setResource) updateMembers(ctx context.Context, state, plan setResourceModel, regionIDs []string, members map[string]setMemberModel) *util.SummaryWithDetailError {
+	renamed := !plan.NamePrefix.Equal(state.NamePrefix)
+	adminPassword := util.MaybeString(plan.AdminPassword)
+	if plan.AdminPassword.Equal(state.AdminPassword) {
+		adminPassword = nil // Not resetting the passwords, e.g., if they were changed outside of Terraform.
+	}
+
+	if !renamed && adminPassword == nil &&
+		sameFirewallRanges(plan.FirewallRanges, state.FirewallRanges) && plan.ExpiresAt.Equal(state.ExpiresAt) {
+		return nil
+	}
+
+	mu := sync.Mutex{}
+
+	return util.InParallel(len(regionIDs), config.WorkspaceGroupSetConcurrency, func(i int) *util.SummaryWithDetailError {
+		mu.Lock()
+		member := members[regionIDs[i]]
+		mu.Unlock()
+
+		var name *string
+		if renamed {
+			n, serr := setMemberName(ctx, r.ProviderData, plan.NamePrefix.ValueString(), regionIDs[i])
+			if serr != nil {
+				return serr
+			}
+
+			name = &n
+		}
+
+		update := management.WorkspaceGroupUpdate{
+			AdminPassword: adminPassword,
+			Name:          name,
+		}
+
+		if !plan.ExpiresAt.Equal(state.ExpiresAt) {
+			update.ExpiresAt = util.MaybeString(plan.ExpiresAt)
+		}
+
+		if !sameFirewallRanges(plan.FirewallRanges, state.FirewallRanges) {
+			update.FirewallRanges = util.Ptr(util.StringFirewallRanges(plan.FirewallRanges))
+		}
+
+		id := uuid.MustParse(member.ID.ValueString())
+		workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, update)
+		if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+			return serr
+		}
+
+		wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout)
+		if werr != nil {
+			return werr
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		members[regionIDs[i]] = toSetMemberModel(wg, util.FirstNotEmpty(util.Deref(adminPassword), member.AdminPassword.ValueString()))
+
+		return nil
+	})
+}
+
+// deleteMembers terminates the workspace groups in the regions concurrently and removes the terminated ones from the members.
+func (r *setResource) deleteMembers(ctx context.Context, regionIDs []string, members map[string]setMemberModel) *util.SummaryWithDetailError {
+	mu := sync.Mutex{}
+
+	return util.InParallel(len(regionIDs), config.WorkspaceGroupSetConcurrency, func(i int) *util.SummaryWithDetailError {
+		mu.Lock()
+		id := uuid.MustParse(members[regionIDs[i]].ID.ValueString())
+		mu.Unlock()
+
+		workspaceGroupDeleteResponse, err := r.DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+			&management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams{Force: util.Ptr(true)}, // Deleting even if workspaces in the group.
+		)
+		if serr := util.StatusOK(workspaceGroupDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
+			return serr
+		}
+
+		if werr := waitStatusTerminated(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupTerminationTimeout); werr != nil {
+			return werr
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		delete(members, regionIDs[i])
+
+		return nil
+	})
+}
+
+// setMemberName returns the name of the workspace group of the set in the region,
+// i.e., the prefix followed by a dash and the code of the region, e.g., aws-oregon-gs1.
+func setMemberName(ctx context.Context, pd util.ProviderData, prefix, regionID string) (string, *util.SummaryWithDetailError) {
+	regions, serr := listRegions(ctx, pd)
+	if serr != nil {
+		return "", serr
+	}
+
+	code := regionID
+	for _, r := range regions {
+		if r.RegionID.String() == regionID {
+			parts := strings.Split(r.Region, " - ")
+			code = strings.TrimSpace(parts[len(parts)-1])
+		}
+	}
+
+	return strings.Join([]string{prefix, code}, "-"), nil
+}
+
+func setMembers(ctx context.Context, model setResourceModel) (map[string]setMemberModel, diag.Diagnostics) {
+	result := map[string]setMemberModel{}
+	if model.WorkspaceGroups.IsNull() || model.WorkspaceGroups.IsUnknown() {
+		return result, nil
+	}
+
+	diags := model.WorkspaceGroups.ElementsAs(ctx, &result, false)
+
+	return result, diags
+}
+
+func toSetMemberModel(workspaceGroup management.WorkspaceGroup, adminPassword string) setMemberModel {
+	return setMemberModel{
+		ID:            util.UUIDStringValue(workspaceGroup.WorkspaceGroupID),
+		Name:          types.StringValue(workspaceGroup.Name),
+		AdminPassword: types.StringValue(adminPassword),
+		CreatedAt:     types.StringValue(workspaceGroup.CreatedAt),
+	}
+}
+
+func toSetResourceModel(ctx context.Context, model setResourceModel, members map[string]setMemberModel) (setResourceModel, diag.Diagnostics) {
+	result := model
+	result.ID = model.NamePrefix
+	result.RegionIDs = util.Map(sortedKeys(members), types.StringValue)
+
+	var diags diag.Diagnostics
+	result.WorkspaceGroups, diags = types.MapValueFrom(ctx, setMemberType, members)
+
+	return result, diags
+}
+
+func sortedKeys(members map[string]setMemberModel) []string {
+	result := make([]string, 0, len(members))
+	for regionID := range members {
+		result = append(result, regionID)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func sortedStrings(ss []string) []string {
+	result := append([]string{}, ss...)
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,166 @@
+package workspacegroups_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceGroupSet(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+		{
+			RegionID: uuid.MustParse("5ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "Europe Central 1 (Frankfurt) - aws-frankfurt-1",
+			Provider: management.AWS,
+		},
+	}
+
+	mu := sync.Mutex{}
+	workspaceGroups := map[uuid.UUID]management.WorkspaceGroup{}
+
+	writeJSON := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(body))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			writeJSON(w, regions)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.AdminPassword, "should let the server generate the passwords")
+
+			id := uuid.New()
+			workspaceGroups[id] = management.WorkspaceGroup{
+				CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+				ExpiresAt:        input.ExpiresAt,
+				FirewallRanges:   util.Ptr(input.FirewallRanges),
+				Name:             input.Name,
+				RegionID:         input.RegionID,
+				State:            management.ACTIVE,
+				WorkspaceGroupID: id,
+			}
+			writeJSON(w, struct {
+				AdminPassword    string `json:"adminPassword"`
+				WorkspaceGroupID uuid.UUID
+			}{
+				AdminPassword:    "generated" + input.Name + "BAR12$",
+				WorkspaceGroupID: id,
+			})
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/"):
+			id := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/v1/workspaceGroups/"))
+			workspaceGroup, ok := workspaceGroups[id]
+			require.True(t, ok, "unknown workspace group %s", id)
+
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, workspaceGroup)
+			case http.MethodPatch:
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var input management.WorkspaceGroupUpdate
+				require.NoError(t, json.Unmarshal(body, &input))
+				require.Nil(t, input.AdminPassword, "should not reset the passwords on unrelated updates")
+				if input.FirewallRanges != nil {
+					workspaceGroup.FirewallRanges = input.FirewallRanges
+				}
+
+				workspaceGroups[id] = workspaceGroup
+				writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: id})
+			case http.MethodDelete:
+				workspaceGroup.State = management.TERMINATED
+				workspaceGroups[id] = workspaceGroup
+				writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: id})
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotImplemented)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	activeNames := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result := []string{}
+		for _, workspaceGroup := range workspaceGroups {
+			if workspaceGroup.State != management.TERMINATED {
+				result = append(result, workspaceGroup.Name)
+			}
+		}
+
+		sort.Strings(result)
+
+		return result
+	}
+
+	oregon := regions[0].RegionID.String()
+	frankfurt := regions[1].RegionID.String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupSetResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "id", "orders"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "region_ids.#", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups.%", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups."+oregon+".name", "orders-aws-oregon-gs1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups."+oregon+".admin_password", "generatedorders-aws-oregon-gs1BAR12$"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups."+frankfurt+".name", "orders-aws-frankfurt-1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, []string{"orders-aws-frankfurt-1", "orders-aws-oregon-gs1"}, activeNames())
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupSetResource).
+					WithWorkspaceGroupSetResource("this")("region_ids", cty.SetVal([]cty.Value{cty.StringVal(oregon)})).
+					WithWorkspaceGroupSetResource("this")("firewall_ranges", cty.SetVal([]cty.Value{cty.StringVal("10.0.0.0/8")})).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups.%", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "workspace_groups."+oregon+".admin_password", "generatedorders-aws-oregon-gs1BAR12$"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_set.this", "firewall_ranges.0", "10.0.0.0/8"),
+				),
+			},
+		},
+	})
+
+	require.Empty(t, activeNames(), "destroying the resource should terminate the workspace groups")
+}
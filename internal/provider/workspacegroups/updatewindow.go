@@ -0,0 +1,274 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceUpdateWindowName = "workspace_group_update_window"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &updateWindowResource{}
+	_ resource.ResourceWithImportState = &updateWindowResource{}
+)
+
+// updateWindowResource is the resource implementation.
+type updateWindowResource struct {
+	util.ProviderData
+}
+
+// updateWindowStandaloneResourceModel maps the resource schema data.
+type updateWindowStandaloneResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
+	Hour             types.Int64  `tfsdk:"hour"`
+	Day              types.Int64  `tfsdk:"day"`
+}
+
+// updateWindowResourceModel maps the update window schema data of the resources.
+type updateWindowResourceModel struct {
+	Hour types.Int64 `tfsdk:"hour"`
+	Day  types.Int64 `tfsdk:"day"`
+}
+
+// newUpdateWindowResourceSchemaAttributes returns the attributes of an update window.
+//
+// Both attributes are required, so that a declared update window is complete, and validated at plan time.
+func newUpdateWindowResourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"hour": schema.Int64Attribute{
+			Required:            true,
+			MarkdownDescription: "The hour of the day, in 24-hour UTC format (0-23), when the update window starts.",
+			Validators:          []validator.Int64{int64validator.Between(0, 23)},
+		},
+		"day": schema.Int64Attribute{
+			Required:            true,
+			MarkdownDescription: "The day of the week (0-6), where 0 is Sunday and 6 is Saturday, when the update window is scheduled.",
+			Validators:          []validator.Int64{int64validator.Between(0, 6)},
+		},
+	}
+}
+
+func toUpdateWindowResourceModel(uw *management.UpdateWindow) *updateWindowResourceModel {
+	if uw == nil {
+		return nil
+	}
+
+	return &updateWindowResourceModel{
+		Hour: types.Int64Value(int64(uw.Hour)),
+		Day:  types.Int64Value(int64(uw.Day)),
+	}
+}
+
+func toManagementUpdateWindow(uw *updateWindowResourceModel) *management.UpdateWindow {
+	if uw == nil {
+		return nil
+	}
+
+	return &management.UpdateWindow{
+		Hour: float32(uw.Hour.ValueInt64()),
+		Day:  float32(uw.Day.ValueInt64()),
+	}
+}
+
+func sameUpdateWindow(a, b *updateWindowResourceModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Hour.Equal(b.Hour) && a.Day.Equal(b.Day)
+}
+
+// NewResourceUpdateWindow is a helper function to simplify the provider implementation.
+func NewResourceUpdateWindow() resource.Resource {
+	return &updateWindowResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *updateWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceUpdateWindowName)
+}
+
+// Schema defines the schema for the resource.
+func (r *updateWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := newUpdateWindowResourceSchemaAttributes()
+	attributes[config.IDAttribute] = schema.StringAttribute{
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the workspace group.",
+	}
+	attributes[config.WorkspaceGroupIDAttribute] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The unique identifier of the existing workspace group to set the update window of.",
+		Validators:          []validator.String{util.NewUUIDValidator()},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the update window of an existing workspace group with this resource, e.g., from a module that does not own the workspace group. Do not declare the update_window attribute of the workspace group resource for the same workspace group. Since the Management API does not allow removing an update window, destroying this resource leaves the update window as is.",
+		Attributes:          attributes,
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *updateWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan updateWindowStandaloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, serr := r.set(ctx, plan)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *updateWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state updateWindowStandaloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceGroup, err := r.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx,
+		uuid.MustParse(state.WorkspaceGroupID.ValueString()),
+		&management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{},
+	)
+	if serr := util.StatusOK(workspaceGroup, err, util.ReturnNilOnNotFound); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspaceGroup.JSON200 == nil || isTerminating(*workspaceGroup.JSON200) {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace group got terminated, deleting the resource from the state file.
+	}
+
+	state = toUpdateWindowStandaloneResourceModel(state.WorkspaceGroupID, workspaceGroup.JSON200.UpdateWindow)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *updateWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan updateWindowStandaloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, serr := r.set(ctx, plan)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+// The Management API does not allow removing the update window, so it is left as is.
+func (r *updateWindowResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *updateWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ImportState results in Terraform managing the resource that was not previously managed.
+func (r *updateWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(config.IDAttribute), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root(config.WorkspaceGroupIDAttribute), req, resp)
+}
+
+func (r *updateWindowResource) set(ctx context.Context, plan updateWindowStandaloneResourceModel) (updateWindowStandaloneResourceModel, *util.SummaryWithDetailError) {
+	id := uuid.MustParse(plan.WorkspaceGroupID.ValueString())
+
+	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+		management.WorkspaceGroupUpdate{
+			UpdateWindow: toManagementUpdateWindow(&updateWindowResourceModel{Hour: plan.Hour, Day: plan.Day}),
+		},
+	)
+	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+		return updateWindowStandaloneResourceModel{}, serr
+	}
+
+	wg, werr := waitStatusActive(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout)
+	if werr != nil {
+		return updateWindowStandaloneResourceModel{}, werr
+	}
+
+	result := toUpdateWindowStandaloneResourceModel(plan.WorkspaceGroupID, wg.UpdateWindow)
+	if !result.Hour.Equal(plan.Hour) || !result.Day.Equal(plan.Day) {
+		return updateWindowStandaloneResourceModel{}, &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to set the update window of the workspace group %s", id),
+			Detail:  "The Management API accepted the update window but returned a different one. " + config.CreateProviderIssueIfNotClearErrorDetail,
+		}
+	}
+
+	return result, nil
+}
+
+func toUpdateWindowStandaloneResourceModel(workspaceGroupID types.String, uw *management.UpdateWindow) updateWindowStandaloneResourceModel {
+	result := updateWindowStandaloneResourceModel{
+		ID:               workspaceGroupID,
+		WorkspaceGroupID: workspaceGroupID,
+		Hour:             types.Int64Null(),
+		Day:              types.Int64Null(),
+	}
+
+	if uw != nil {
+		result.Hour = types.Int64Value(int64(uw.Hour))
+		result.Day = types.Int64Value(int64(uw.Day))
+	}
+
+	return result
+}
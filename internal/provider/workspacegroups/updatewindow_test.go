@@ -0,0 +1,99 @@
+package workspacegroups_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceGroupUpdateWindow(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+		State:            management.ACTIVE,
+		WorkspaceGroupID: workspaceGroupID,
+	}
+
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, strings.Join([]string{"/v1/workspaceGroups", workspaceGroupID.String()}, "/"), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+
+		switch r.Method {
+		case http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case http.MethodPatch:
+			patches++
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceGroupUpdate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Nil(t, input.Name, "should patch the update window only")
+			require.Nil(t, input.FirewallRanges, "should patch the update window only")
+			workspaceGroup.UpdateWindow = input.UpdateWindow
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupUpdateWindowResource).
+					WithWorkspaceGroupUpdateWindowResource("this")("hour", cty.NumberIntVal(24)).
+					String(),
+				ExpectError: regexp.MustCompile(`hour`),
+			},
+			{
+				Config: examples.WorkspaceGroupUpdateWindowResource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_update_window.this", config.IDAttribute, workspaceGroupID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_update_window.this", "hour", "3"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_update_window.this", "day", "6"),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupUpdateWindowResource).
+					WithWorkspaceGroupUpdateWindowResource("this")("day", cty.NumberIntVal(0)).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_workspace_group_update_window.this", "day", "0"),
+			},
+			{
+				ResourceName:      "singlestoredb_workspace_group_update_window.this",
+				ImportState:       true,
+				ImportStateId:     workspaceGroupID.String(),
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	require.Equal(t, 2, patches)
+	require.NotNil(t, workspaceGroup.UpdateWindow, "destroying should leave the update window as is")
+	require.Equal(t, management.ACTIVE, workspaceGroup.State, "destroying should not terminate the workspace group")
+}
@@ -0,0 +1,66 @@
+package workspacegroups
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// verifyUpdate compares the workspace group read back after an update with the plan.
+//
+// It returns the names of the attributes that the Management API silently ignored.
+// The result keeps the planned values of those attributes, so that Terraform does not reject
+// the apply as inconsistent and the next refresh shows the difference instead.
+func verifyUpdate(plan, result workspaceGroupResourceModel) (workspaceGroupResourceModel, []string) {
+	ignored := []string{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(result.Name) {
+		ignored = append(ignored, "name")
+		result.Name = plan.Name
+	}
+
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() && !isDuration(plan.ExpiresAt) && !sameTime(plan.ExpiresAt, result.ExpiresAt) {
+		ignored = append(ignored, "expires_at")
+		result.ExpiresAt = plan.ExpiresAt
+	}
+
+	if !sameFirewallRanges(plan.FirewallRanges, result.FirewallRanges) {
+		ignored = append(ignored, "firewall_ranges")
+		result.FirewallRanges = plan.FirewallRanges
+	}
+
+	if plan.UpdateWindow != nil && !sameUpdateWindow(plan.UpdateWindow, result.UpdateWindow) {
+		ignored = append(ignored, "update_window")
+		result.UpdateWindow = plan.UpdateWindow
+	}
+
+	return result, ignored
+}
+
+func sameTime(a, b types.String) bool {
+	if a.Equal(b) {
+		return true
+	}
+
+	at, aerr := time.Parse(time.RFC3339, a.ValueString())
+	bt, berr := time.Parse(time.RFC3339, b.ValueString())
+
+	return aerr == nil && berr == nil && at.Equal(bt)
+}
+
+func sameFirewallRanges(a, b []types.String) bool {
+	as := util.StringFirewallRanges(a)
+	bs := util.StringFirewallRanges(b)
+	if len(as) != len(bs) {
+		return false
+	}
+
+	for _, r := range as {
+		if !util.Any(bs, r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,205 @@
+package workspaces
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceCertificateName = "workspace_certificate"
+)
+
+// workspaceCertificateDataSource is the data source implementation.
+type workspaceCertificateDataSource struct {
+	util.ProviderData
+}
+
+// workspaceCertificateDataSourceModel maps the data source schema data.
+type workspaceCertificateDataSourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Port               types.Int64    `tfsdk:"port"`
+	Endpoint           types.String   `tfsdk:"endpoint"`
+	FingerprintsSHA256 []types.String `tfsdk:"fingerprints_sha256"`
+	NotAfter           types.String   `tfsdk:"not_after"`
+}
+
+var _ datasource.DataSourceWithConfigure = &workspaceCertificateDataSource{}
+
+// NewDataSourceCertificate is a helper function to simplify the provider implementation.
+func NewDataSourceCertificate() datasource.DataSource {
+	return &workspaceCertificateDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceCertificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceCertificateName)
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceCertificateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to fetch the fingerprints of the TLS certificate chain that the endpoint of an active workspace presents, e.g., to configure clients that pin certificates. Since the fingerprints are read on every refresh, the dependent configuration follows once SingleStore rotates the certificates. The chain is fetched as presented and is not verified; compare it with the SingleStore CA bundle if the network path is not trusted.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The TLS port of the endpoint. Defaults to %d, the HTTPS port of the workspace.", config.WorkspaceHTTPSPort),
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The endpoint of the workspace.",
+			},
+			"fingerprints_sha256": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The lowercase hex encoded SHA-256 fingerprints of the certificates in the chain, starting with the leaf certificate of the endpoint.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The earliest expiration timestamp among the certificates in the chain, in the RFC3339 format. The certificates are rotated before this time.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceCertificateDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			"Invalid workspace ID",
+			"The workspace ID should be a valid UUID",
+		)
+
+		return
+	}
+
+	workspace, err := d.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspace.JSON200.State != management.WorkspaceStateACTIVE || workspace.JSON200.Endpoint == nil {
+		resp.Diagnostics.AddError(
+			"Workspace is not active",
+			fmt.Sprintf("The certificate of workspace %s is available only while its state is %s, but the state is %s.",
+				id, management.WorkspaceStateACTIVE, workspace.JSON200.State),
+		)
+
+		return
+	}
+
+	port := int64(config.WorkspaceHTTPSPort)
+	if !data.Port.IsNull() {
+		port = data.Port.ValueInt64()
+	}
+
+	endpoint := *workspace.JSON200.Endpoint
+	chain, err := fetchCertificateChain(ctx, net.JoinHostPort(endpoint, strconv.FormatInt(port, 10)), endpoint, config.WorkspaceTLSHandshakeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch the certificate chain of the workspace",
+			fmt.Sprintf("Ensure that the firewall ranges of the workspace group allow this machine.\n\n%s", err),
+		)
+
+		return
+	}
+
+	result := workspaceCertificateDataSourceModel{
+		ID:                 data.ID,
+		Port:               types.Int64Value(port),
+		Endpoint:           types.StringValue(endpoint),
+		FingerprintsSHA256: util.Map(chain, fingerprintSHA256),
+		NotAfter:           types.StringValue(earliestNotAfter(chain).UTC().Format(time.RFC3339)),
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceCertificateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// fetchCertificateChain completes a TLS handshake with the address and returns the presented certificates.
+func fetchCertificateChain(ctx context.Context, address, serverName string, timeout time.Duration) ([]*x509.Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName:         serverName,
+			InsecureSkipVerify: true, //nolint:gosec // Only reading the presented chain, no data is exchanged.
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete the TLS handshake with %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	chain := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("the endpoint %s did not present any certificate", address)
+	}
+
+	return chain, nil
+}
+
+func fingerprintSHA256(cert *x509.Certificate) types.String {
+	sum := sha256.Sum256(cert.Raw)
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+func earliestNotAfter(chain []*x509.Certificate) time.Time {
+	result := chain[0].NotAfter
+	for _, cert := range chain[1:] {
+		if cert.NotAfter.Before(result) {
+			result = cert.NotAfter
+		}
+	}
+
+	return result
+}
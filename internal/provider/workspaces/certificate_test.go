@@ -0,0 +1,110 @@
+package workspaces_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestReadsWorkspaceCertificate(t *testing.T) {
+	endpoint := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(endpoint.Close)
+
+	host, port, err := net.SplitHostPort(endpoint.Listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	cert := endpoint.Certificate()
+	sum := sha256.Sum256(cert.Raw)
+
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Endpoint:         util.Ptr(host),
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceCertificateDataSource).
+					WithWorkspaceCertificateDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					WithWorkspaceCertificateDataSource("this")("port", cty.NumberIntVal(int64(portNumber))).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", config.IDAttribute, workspace.WorkspaceID.String()),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", "port", port),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", "endpoint", host),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", "fingerprints_sha256.#", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", "fingerprints_sha256.0", hex.EncodeToString(sum[:])),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_certificate.this", "not_after", cert.NotAfter.UTC().Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceCertificateFailsForSuspendedWorkspace(t *testing.T) {
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateSUSPENDED,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceCertificateDataSource).
+					WithWorkspaceCertificateDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					String(),
+				ExpectError: regexp.MustCompile("Workspace is not active"),
+			},
+		},
+	})
+}
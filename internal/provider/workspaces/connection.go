@@ -0,0 +1,235 @@
+package workspaces
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceConnectionName = "workspace_connection"
+)
+
+// workspaceConnectionDataSource is the data source implementation.
+type workspaceConnectionDataSource struct {
+	util.ProviderData
+}
+
+// workspaceConnectionDataSourceModel maps the data source schema data.
+type workspaceConnectionDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	TLS        types.String `tfsdk:"tls"`
+	CACert     types.String `tfsdk:"ca_cert"`
+	SecretData types.Map    `tfsdk:"secret_data"`
+}
+
+var _ datasource.DataSourceWithConfigure = &workspaceConnectionDataSource{}
+
+// NewDataSourceConnection is a helper function to simplify the provider implementation.
+func NewDataSourceConnection() datasource.DataSource {
+	return &workspaceConnectionDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceConnectionName)
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Build the SQL connection configuration for a workspace with this data source. The result is shaped for direct use by the mysql provider or as a Kubernetes secret payload.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The SQL user to connect as. Defaults to '%s', the admin user of the workspace group.", config.WorkspaceAdminUsername),
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the SQL user, e.g., the admin password of the workspace group. It is passed through to the resulting connection configuration.",
+			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The host name of the workspace endpoint.",
+			},
+			"port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The port of the SQL endpoint of the workspace.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The endpoint in the host:port notation, as expected by the mysql provider.",
+			},
+			"tls": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The TLS setting to connect with, as expected by the tls argument of the mysql provider. Always '%s' since workspaces require TLS.", config.WorkspaceTLS),
+			},
+			"ca_cert": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The PEM encoded SingleStore CA bundle for verifying the TLS certificate of the workspace, downloaded from %s.", config.SingleStoreCABundleURL),
+			},
+			"secret_data": schema.MapAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The connection configuration as a flat map of strings, suitable as the data of a Kubernetes secret. The password is included only if it is specified.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceConnectionDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			"Invalid workspace ID",
+			"The workspace ID should be a valid UUID",
+		)
+
+		return
+	}
+
+	workspace, err := d.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if workspace.JSON200.State != management.WorkspaceStateACTIVE || workspace.JSON200.Endpoint == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			fmt.Sprintf("Workspace %s state is %s while it should be %s", id, workspace.JSON200.State, management.WorkspaceStateACTIVE),
+			"The workspace does not have an endpoint to connect to. Resume the workspace before reading its connection configuration.",
+		)
+
+		return
+	}
+
+	caCert, err := fetchCABundle(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to download the SingleStore CA bundle",
+			err.Error(),
+		)
+
+		return
+	}
+
+	username := util.FirstNotEmpty(data.Username.ValueString(), config.WorkspaceAdminUsername)
+	host := *workspace.JSON200.Endpoint
+	secretData := map[string]string{
+		"host":     host,
+		"port":     strconv.Itoa(config.WorkspaceSQLPort),
+		"endpoint": net.JoinHostPort(host, strconv.Itoa(config.WorkspaceSQLPort)),
+		"username": username,
+		"tls":      config.WorkspaceTLS,
+		"ca_cert":  caCert,
+	}
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
+		secretData["password"] = data.Password.ValueString()
+	}
+
+	secretDataValue, diags := types.MapValueFrom(ctx, types.StringType, secretData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := workspaceConnectionDataSourceModel{
+		ID:         data.ID,
+		Username:   types.StringValue(username),
+		Password:   data.Password,
+		Host:       types.StringValue(host),
+		Port:       types.Int64Value(config.WorkspaceSQLPort),
+		Endpoint:   types.StringValue(secretData["endpoint"]),
+		TLS:        types.StringValue(config.WorkspaceTLS),
+		CACert:     types.StringValue(caCert),
+		SecretData: secretDataValue,
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceConnectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// fetchCABundle downloads the PEM encoded SingleStore CA bundle.
+func fetchCABundle(ctx context.Context) (string, error) {
+	url := util.FirstNotEmpty(os.Getenv(config.EnvCABundleURL), config.SingleStoreCABundleURL)
+
+	ctx, cancel := context.WithTimeout(ctx, config.HTTPRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := util.NewHTTPClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download %s: status code %s", url, http.StatusText(resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", url, err)
+	}
+
+	if block, _ := pem.Decode(body); block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("failed to download %s: the response is not a PEM encoded certificate", url)
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,117 @@
+package workspaces_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestReadsWorkspaceConnection(t *testing.T) {
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Endpoint:         util.Ptr("svc-94a328d2-8c3d-412d-91a0-c32a750673cb-dml.aws-oregon-3.svc.singlestore.com"),
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	caBundle := "-----BEGIN CERTIFICATE-----\nMAA=\n-----END CERTIFICATE-----\n"
+	caServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(caBundle))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(caServer.Close)
+	t.Setenv(config.EnvCABundleURL, caServer.URL)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		w.Header().Add("Content-Type", "json") // Necessary to make the library parse the resulting JSON.
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceConnectionDataSource).
+					WithWorkspaceConnectionDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", config.IDAttribute, workspace.WorkspaceID.String()),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "username", config.WorkspaceAdminUsername),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "password", config.TestInitialAdminPassword),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "host", *workspace.Endpoint),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "port", "3306"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "endpoint", fmt.Sprintf("%s:3306", *workspace.Endpoint)),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "tls", config.WorkspaceTLS),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "ca_cert", caBundle),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "secret_data.ca_cert", caBundle),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "secret_data.host", *workspace.Endpoint),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "secret_data.password", config.TestInitialAdminPassword),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceConnectionDataSource).
+					WithWorkspaceConnectionDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					WithWorkspaceConnectionDataSource("this")("username", cty.StringVal("reader")).
+					WithWorkspaceConnectionDataSource("this")("password", cty.NullVal(cty.String)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "username", "reader"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_connection.this", "secret_data.username", "reader"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_workspace_connection.this", "secret_data.password"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadsWorkspaceConnectionSuspended(t *testing.T) {
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateSUSPENDED,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceConnectionDataSource).
+					WithWorkspaceConnectionDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					String(),
+				ExpectError: regexp.MustCompile("Resume the workspace"),
+			},
+		},
+	})
+}
@@ -0,0 +1,487 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceFleetName = "workspace_fleet"
+)
+
+var (
+	_ resource.ResourceWithConfigure  = &workspaceFleetResource{}
+	_ resource.ResourceWithModifyPlan = &workspaceFleetResource{}
+)
+
+// workspaceFleetResource is the resource implementation.
+type workspaceFleetResource struct {
+	util.ProviderData
+}
+
+// workspaceFleetResourceModel maps the resource schema data.
+type workspaceFleetResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
+	NamePrefix       types.String `tfsdk:"name_prefix"`
+	Size             types.String `tfsdk:"size"`
+	WorkspaceCount   types.Int64  `tfsdk:"workspace_count"`
+	WorkspaceIDs     types.List   `tfsdk:"workspace_ids"`
+	Endpoints        types.List   `tfsdk:"endpoints"`
+}
+
+// fleetMember is a workspace of a fleet, named as the name prefix followed by a dash and the index.
+type fleetMember struct {
+	index     int
+	workspace management.Workspace
+}
+
+// NewResourceFleet is a helper function to simplify the provider implementation.
+func NewResourceFleet() resource.Resource {
+	return &workspaceFleetResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceFleetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceFleetName)
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceFleetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a fleet of identically sized workspaces in a workspace group. The workspaces are named as the name prefix followed by a dash and an index starting from 1, e.g., reader-1. Changing the count creates or deletes the workspaces with the highest indices; changing the size scales all the workspaces. The workspaces are created, scaled, and deleted concurrently.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the fleet in the workspace_group_id/name_prefix notation.",
+			},
+			"workspace_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace group that the workspaces belong to.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"name_prefix": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The prefix of the workspace names. It should not collide with the names of the other workspaces in the workspace group.",
+			},
+			"size": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The size of each workspace, specified in workspace size notation (S-00, S-0, S-1, S-2).",
+				Validators:          []validator.String{NewSizeValidator()},
+			},
+			"workspace_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of the workspaces in the fleet.",
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"workspace_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the workspaces ordered by index.",
+			},
+			"endpoints": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The endpoints of the workspaces ordered by index.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workspaceFleetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceFleetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, serr := r.createMembers(ctx, plan, fleetIndices(1, int(plan.WorkspaceCount.ValueInt64())))
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result, diags := toWorkspaceFleetResourceModel(ctx, plan, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workspaceFleetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceFleetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, found, serr := r.listMembers(ctx, state)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace group got terminated externally, deleting the fleet from the state file to recreate.
+	}
+
+	result, diags := toWorkspaceFleetResourceModel(ctx, state, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// The surplus workspaces are deleted first, then the remaining ones are scaled, and finally the missing ones are created.
+func (r *workspaceFleetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workspaceFleetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, _, serr := r.listMembers(ctx, plan)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	count := int(plan.WorkspaceCount.ValueInt64())
+	kept := []fleetMember{}
+	surplus := []fleetMember{}
+	existing := map[int]bool{}
+	for _, m := range members {
+		if m.index > count {
+			surplus = append(surplus, m)
+
+			continue
+		}
+
+		kept = append(kept, m)
+		existing[m.index] = true
+	}
+
+	missing := []int{}
+	for _, i := range fleetIndices(1, count) {
+		if !existing[i] {
+			missing = append(missing, i)
+		}
+	}
+
+	if serr := r.deleteMembers(ctx, surplus); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	scaled, serr := r.scaleMembers(ctx, kept, plan.Size.ValueString())
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	created, serr := r.createMembers(ctx, plan, missing)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result, diags := toWorkspaceFleetResourceModel(ctx, plan, append(scaled, created...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workspaceFleetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceFleetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, _, serr := r.listMembers(ctx, state)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if serr := r.deleteMembers(ctx, members); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceFleetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ModifyPlan emits an error if a required yet immutable field changes.
+//
+// `RequiresReplace` is not used because deleting the workspaces result in losing database attachments.
+func (r *workspaceFleetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state *workspaceFleetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+
+	var plan *workspaceFleetResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	if !plan.WorkspaceGroupID.Equal(state.WorkspaceGroupID) {
+		resp.Diagnostics.AddError("Cannot update workspace fleet group ID",
+			"To prevent accidental deletion of the databases that are attached to the workspaces, updating the workspace group ID is not permitted. "+
+				"Please explicitly delete the workspace fleet before changing its workspace group ID.")
+
+		return
+	}
+
+	if !plan.NamePrefix.Equal(state.NamePrefix) {
+		resp.Diagnostics.AddError("Cannot update workspace fleet name prefix",
+			"To prevent accidental deletion of the databases that are attached to the workspaces, updating the name prefix is not permitted. "+
+				"Please explicitly delete the workspace fleet before changing its name prefix.")
+
+		return
+	}
+}
+
+// listMembers lists the workspaces of the fleet ordered by index.
+// It returns false if the workspace group is not found.
+func (r *workspaceFleetResource) listMembers(ctx context.Context, model workspaceFleetResourceModel) ([]fleetMember, bool, *util.SummaryWithDetailError) {
+	workspaces, err := r.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{
+		WorkspaceGroupID: uuid.MustParse(model.WorkspaceGroupID.ValueString()),
+	})
+	if serr := util.StatusOK(workspaces, err, util.ReturnNilOnNotFound); serr != nil {
+		return nil, false, serr
+	}
+
+	if workspaces.JSON200 == nil {
+		return nil, false, nil
+	}
+
+	result := []fleetMember{}
+	for _, w := range *workspaces.JSON200 {
+		index, ok := fleetIndex(model.NamePrefix.ValueString(), w.Name)
+		if !ok || w.State == management.WorkspaceStateTERMINATED {
+			continue
+		}
+
+		result = append(result, fleetMember{index: index, workspace: w})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].index < result[j].index })
+
+	return result, true, nil
+}
+
+func (r *workspaceFleetResource) createMembers(ctx context.Context, plan workspaceFleetResourceModel, indices []int) ([]fleetMember, *util.SummaryWithDetailError) {
+	result := make([]fleetMember, len(indices))
+	serr := inParallel(len(indices), func(i int) *util.SummaryWithDetailError {
+		workspaceCreateResponse, err := r.PostV1WorkspacesWithResponse(ctx, management.PostV1WorkspacesJSONRequestBody{
+			Name:             fleetMemberName(plan.NamePrefix.ValueString(), indices[i]),
+			Size:             util.MaybeString(plan.Size),
+			WorkspaceGroupID: uuid.MustParse(plan.WorkspaceGroupID.ValueString()),
+		})
+		if serr := util.StatusOK(workspaceCreateResponse, err); serr != nil {
+			return serr
+		}
+
+		w, werr := wait(ctx, r.ClientWithResponsesInterface, workspaceCreateResponse.JSON200.WorkspaceID, config.WorkspaceCreationTimeout,
+			waitConditionState(management.WorkspaceStateACTIVE),
+		)
+		if werr != nil {
+			return werr
+		}
+
+		result[i] = fleetMember{index: indices[i], workspace: w}
+
+		return nil
+	})
+
+	return result, serr
+}
+
+func (r *workspaceFleetResource) scaleMembers(ctx context.Context, members []fleetMember, desiredSize string) ([]fleetMember, *util.SummaryWithDetailError) {
+	result := make([]fleetMember, len(members))
+	serr := inParallel(len(members), func(i int) *util.SummaryWithDetailError {
+		result[i] = members[i]
+		if members[i].workspace.Size == desiredSize {
+			return nil
+		}
+
+		id := members[i].workspace.WorkspaceID
+		workspaceUpdateResponse, err := r.PatchV1WorkspacesWorkspaceIDWithResponse(ctx, id,
+			management.WorkspaceUpdate{
+				Size: util.Ptr(desiredSize),
+			},
+		)
+		if serr := util.StatusOK(workspaceUpdateResponse, err); serr != nil {
+			return serr
+		}
+
+		w, werr := wait(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceResumeTimeout,
+			waitConditionState(management.WorkspaceStateACTIVE),
+			waitConditionSize(desiredSize),
+			waitConditionTakesAtLeast(config.WorkspaceScaleTakesAtLeast),
+		)
+		if werr != nil {
+			return werr
+		}
+
+		result[i].workspace = w
+
+		return nil
+	})
+
+	return result, serr
+}
+
+func (r *workspaceFleetResource) deleteMembers(ctx context.Context, members []fleetMember) *util.SummaryWithDetailError {
+	return inParallel(len(members), func(i int) *util.SummaryWithDetailError {
+		workspaceDeleteResponse, err := r.DeleteV1WorkspacesWorkspaceIDWithResponse(ctx, members[i].workspace.WorkspaceID)
+
+		return util.StatusOK(workspaceDeleteResponse, err, util.ReturnNilOnNotFound)
+	})
+}
+
+func toWorkspaceFleetResourceModel(ctx context.Context, model workspaceFleetResourceModel, members []fleetMember) (workspaceFleetResourceModel, diag.Diagnostics) {
+	sort.Slice(members, func(i, j int) bool { return members[i].index < members[j].index })
+
+	result := model
+	result.ID = types.StringValue(strings.Join([]string{model.WorkspaceGroupID.ValueString(), model.NamePrefix.ValueString()}, "/"))
+	result.WorkspaceCount = types.Int64Value(int64(len(members)))
+
+	ids := make([]string, 0, len(members))
+	endpoints := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.workspace.WorkspaceID.String())
+		endpoints = append(endpoints, util.Deref(m.workspace.Endpoint))
+		if m.workspace.Size != model.Size.ValueString() {
+			result.Size = types.StringValue(m.workspace.Size) // Showing the drift of any workspace.
+		}
+	}
+
+	var diags diag.Diagnostics
+	result.WorkspaceIDs, diags = types.ListValueFrom(ctx, types.StringType, ids)
+	if diags.HasError() {
+		return result, diags
+	}
+
+	result.Endpoints, diags = types.ListValueFrom(ctx, types.StringType, endpoints)
+
+	return result, diags
+}
+
+// inParallel calls f for each index from 0 to n-1 concurrently and returns the first error.
+func inParallel(n int, f func(i int) *util.SummaryWithDetailError) *util.SummaryWithDetailError {
+	return util.InParallel(n, config.WorkspaceFleetConcurrency, f)
+}
+
+func fleetIndices(from, to int) []int {
+	result := []int{}
+	for i := from; i <= to; i++ {
+		result = append(result, i)
+	}
+
+	return result
+}
+
+func fleetMemberName(prefix string, index int) string {
+	return fmt.Sprintf("%s-%d", prefix, index)
+}
+
+// fleetIndex returns the index of the workspace if the name belongs to the fleet.
+func fleetIndex(prefix, name string) (int, bool) {
+	suffix, ok := strings.CutPrefix(name, prefix+"-")
+	if !ok {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 1 || fleetMemberName(prefix, index) != name {
+		return 0, false
+	}
+
+	return index, true
+}
@@ -0,0 +1,192 @@
+package workspaces_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCRUDWorkspaceFleet(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		AllowAllTraffic:  util.Ptr(false),
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	mu := sync.Mutex{}
+	workspaces := map[uuid.UUID]management.Workspace{}
+
+	writeJSON := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(body))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		workspaceGroupPath := strings.Join([]string{"/v1/workspaceGroups", workspaceGroup.WorkspaceGroupID.String()}, "/")
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			writeJSON(w, regions)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID})
+		case r.URL.Path == workspaceGroupPath && r.Method == http.MethodGet:
+			writeJSON(w, workspaceGroup)
+		case r.URL.Path == workspaceGroupPath && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID})
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodGet:
+			require.Equal(t, workspaceGroup.WorkspaceGroupID.String(), r.URL.Query().Get("workspaceGroupID"))
+			result := []management.Workspace{}
+			for _, workspace := range workspaces {
+				result = append(result, workspace)
+			}
+
+			sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+			writeJSON(w, result)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			var input management.PostV1WorkspacesJSONRequestBody
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			require.Equal(t, workspaceGroup.WorkspaceGroupID, input.WorkspaceGroupID)
+			require.Equal(t, config.TestInitialWorkspaceSize, util.Deref(input.Size))
+
+			id := uuid.New()
+			workspaces[id] = management.Workspace{
+				CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+				Endpoint:         util.Ptr(id.String() + ".svc.singlestore.com"),
+				Name:             input.Name,
+				Size:             util.Deref(input.Size),
+				State:            management.WorkspaceStateACTIVE,
+				WorkspaceGroupID: input.WorkspaceGroupID,
+				WorkspaceID:      id,
+			}
+			writeJSON(w, struct{ WorkspaceID uuid.UUID }{WorkspaceID: id})
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/"):
+			id := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/v1/workspaces/"))
+			workspace, ok := workspaces[id]
+			require.True(t, ok, "unknown workspace %s", id)
+
+			if r.Method == http.MethodDelete {
+				delete(workspaces, id)
+				writeJSON(w, struct{ WorkspaceID uuid.UUID }{WorkspaceID: id})
+
+				return
+			}
+
+			require.Equal(t, http.MethodGet, r.Method)
+			writeJSON(w, workspace)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	names := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result := []string{}
+		for _, workspace := range workspaces {
+			result = append(result, workspace.Name)
+		}
+
+		sort.Strings(result)
+
+		return result
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceFleetResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", config.IDAttribute, workspaceGroup.WorkspaceGroupID.String()+"/reader"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", "workspace_count", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", "workspace_ids.#", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", "endpoints.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, []string{"reader-1", "reader-2"}, names())
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceFleetResource).
+					WithWorkspaceFleetResource("this")("workspace_count", cty.NumberIntVal(1)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", "workspace_count", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_fleet.this", "workspace_ids.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, []string{"reader-1"}, names(), "the surplus workspace should be deleted")
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceFleetResource).
+					WithWorkspaceFleetResource("this")("workspace_count", cty.NumberIntVal(1)).
+					WithWorkspaceFleetResource("this")("name_prefix", cty.StringVal("writer")).
+					String(),
+				ExpectError: regexp.MustCompile("Cannot update workspace fleet name prefix"),
+			},
+		},
+	})
+
+	require.Empty(t, names(), "destroying the fleet should delete all its workspaces")
+}
+
+func TestWorkspaceFleetNegativeCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.False(t, true, "should not get here")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceFleetResource).
+					WithWorkspaceFleetResource("this")("workspace_count", cty.NumberIntVal(-1)).
+					String(),
+				ExpectError: regexp.MustCompile("workspace_count"),
+			},
+		},
+	})
+}
@@ -21,20 +21,22 @@ const (
 
 // workspacesDataSourceGet is the data source implementation.
 type workspacesDataSourceGet struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspaceDataSourceModel maps workspace schema data.
 type workspaceDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
-	Name             types.String `tfsdk:"name"`
-	State            types.String `tfsdk:"state"`
-	Size             types.String `tfsdk:"size"`
-	Suspended        types.Bool   `tfsdk:"suspended"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	Endpoint         types.String `tfsdk:"endpoint"`
-	LastResumedAt    types.String `tfsdk:"last_resumed_at"`
+	ID                 types.String `tfsdk:"id"`
+	WorkspaceGroupID   types.String `tfsdk:"workspace_group_id"`
+	Name               types.String `tfsdk:"name"`
+	State              types.String `tfsdk:"state"`
+	Size               types.String `tfsdk:"size"`
+	Suspended          types.Bool   `tfsdk:"suspended"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	DataAPIURL         types.String `tfsdk:"data_api_url"`
+	ConnectionPoolSize types.Int64  `tfsdk:"recommended_connection_pool_size"`
+	LastResumedAt      types.String `tfsdk:"last_resumed_at"`
 }
 
 type workspaceDataSourceSchemaConfig struct {
@@ -132,7 +134,7 @@ func (d *workspacesDataSourceGet) Configure(_ context.Context, req datasource.Co
 		return // Should not return an error for unknown reasons.
 	}
 
-	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 func newWorkspaceDataSourceSchemaAttributes(conf workspaceDataSourceSchemaConfig) map[string]schema.Attribute {
@@ -171,6 +173,14 @@ func newWorkspaceDataSourceSchemaAttributes(conf workspaceDataSourceSchemaConfig
 			Computed:            true,
 			MarkdownDescription: "The endpoint to connect to the workspace.",
 		},
+		"data_api_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The base URL of the SingleStore Data API of the workspace for running SQL statements over HTTPS. It is not set while the workspace is suspended.",
+		},
+		"recommended_connection_pool_size": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The recommended maximum size of the connection pool of an application connecting to the workspace, derived from the vCPUs of the size at %d connections per vCPU, e.g., 8 for S-00. Use it to template the pool settings of the applications, and lower it if several applications share the workspace.", config.WorkspaceConnectionsPerVCPU),
+		},
 		"last_resumed_at": schema.StringAttribute{
 			Computed:            true,
 			MarkdownDescription: "The timestamp indicating the most recent time that the workspace was resumed from suspension. If the workspace has never been suspended, this attribute will not be included in the output.",
@@ -180,14 +190,16 @@ func newWorkspaceDataSourceSchemaAttributes(conf workspaceDataSourceSchemaConfig
 
 func toWorkspaceDataSourceModel(workspace management.Workspace) (workspaceDataSourceModel, *util.SummaryWithDetailError) {
 	return workspaceDataSourceModel{
-		ID:               util.UUIDStringValue(workspace.WorkspaceID),
-		WorkspaceGroupID: util.UUIDStringValue(workspace.WorkspaceGroupID),
-		Name:             types.StringValue(workspace.Name),
-		State:            util.WorkspaceStateStringValue(workspace.State),
-		Size:             types.StringValue(workspace.Size),
-		Suspended:        types.BoolValue(workspace.State == management.WorkspaceStateSUSPENDED),
-		CreatedAt:        types.StringValue(workspace.CreatedAt),
-		Endpoint:         util.MaybeStringValue(workspace.Endpoint),
-		LastResumedAt:    util.MaybeStringValue(workspace.LastResumedAt),
+		ID:                 util.UUIDStringValue(workspace.WorkspaceID),
+		WorkspaceGroupID:   util.UUIDStringValue(workspace.WorkspaceGroupID),
+		Name:               types.StringValue(workspace.Name),
+		State:              util.WorkspaceStateStringValue(workspace.State),
+		Size:               types.StringValue(workspace.Size),
+		Suspended:          types.BoolValue(workspace.State == management.WorkspaceStateSUSPENDED),
+		CreatedAt:          types.StringValue(workspace.CreatedAt),
+		Endpoint:           util.MaybeStringValue(workspace.Endpoint),
+		DataAPIURL:         dataAPIURLValue(workspace.Endpoint),
+		ConnectionPoolSize: recommendedConnectionPoolSizeValue(types.StringValue(workspace.Size)),
+		LastResumedAt:      util.MaybeStringValue(workspace.LastResumedAt),
 	}, nil
 }
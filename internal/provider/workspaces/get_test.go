@@ -54,8 +54,10 @@ func TestReadsWorkspace(t *testing.T) {
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "name", workspace.Name),
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "state", string(workspace.State)),
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "size", workspace.Size),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "recommended_connection_pool_size", "8"),
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "created_at", workspace.CreatedAt),
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "endpoint", *workspace.Endpoint),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "data_api_url", "https://"+*workspace.Endpoint+"/api/v2"),
 					resource.TestCheckResourceAttr("data.singlestoredb_workspace.this", "last_resumed_at", *workspace.LastResumedAt),
 				),
 			},
@@ -0,0 +1,394 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceGroupWorkspacesName = "workspace_group_workspaces"
+
+	manageExistingWorkspacesReport = "report"
+	manageExistingWorkspacesAdopt  = "adopt"
+)
+
+var (
+	_ resource.ResourceWithConfigure  = &groupWorkspacesResource{}
+	_ resource.ResourceWithModifyPlan = &groupWorkspacesResource{}
+)
+
+// groupWorkspacesResource is the resource implementation.
+type groupWorkspacesResource struct {
+	util.ProviderData
+}
+
+// groupWorkspacesResourceModel maps the resource schema data.
+type groupWorkspacesResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	WorkspaceGroupID         types.String `tfsdk:"workspace_group_id"`
+	ManagedWorkspaceIDs      types.Set    `tfsdk:"managed_workspace_ids"`
+	ManageExistingWorkspaces types.String `tfsdk:"manage_existing_workspaces"`
+	UnmanagedWorkspaceIDs    types.Set    `tfsdk:"unmanaged_workspace_ids"`
+	AdoptedWorkspaceIDs      types.Set    `tfsdk:"adopted_workspace_ids"`
+}
+
+// NewResourceGroupWorkspaces is a helper function to simplify the provider implementation.
+func NewResourceGroupWorkspaces() resource.Resource {
+	return &groupWorkspacesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *groupWorkspacesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceGroupWorkspacesName)
+}
+
+// Schema defines the schema for the resource.
+func (r *groupWorkspacesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource discovers the workspaces of a workspace group that are not managed by Terraform, e.g., the ones created in the SingleStore Portal. A workspace is unmanaged unless its ID is listed in managed_workspace_ids, which is meant to reference the workspaces and fleets of the configuration. Depending on manage_existing_workspaces, the unmanaged workspaces are either reported as warnings or adopted by this resource. Adopted workspaces are terminated when this resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the workspace group.",
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace group to discover the workspaces of.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"managed_workspace_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the workspaces that are managed by other resources, e.g., singlestoredb_workspace.this.id. These workspaces are neither reported nor adopted. Listing an adopted workspace here releases it to the other resource.",
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(util.NewUUIDValidator())},
+			},
+			"manage_existing_workspaces": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(manageExistingWorkspacesReport),
+				MarkdownDescription: fmt.Sprintf("What to do with the unmanaged workspaces, either '%s' or '%s'. With '%s', every refresh warns about the unmanaged workspaces, and nothing is changed. With '%s', each plan shows the newly discovered workspaces being added to adopted_workspace_ids, and applying it makes this resource own them, so that destroying the resource terminates them. Switching back to '%s' releases the adopted workspaces without terminating them. Defaults to '%s'.", manageExistingWorkspacesReport, manageExistingWorkspacesAdopt, manageExistingWorkspacesReport, manageExistingWorkspacesAdopt, manageExistingWorkspacesReport, manageExistingWorkspacesReport),
+				Validators:          []validator.String{stringvalidator.OneOf(manageExistingWorkspacesReport, manageExistingWorkspacesAdopt)},
+			},
+			"unmanaged_workspace_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the workspaces that are neither managed nor adopted.",
+			},
+			"adopted_workspace_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the workspaces that this resource owns. The workspaces that get terminated externally are forgotten.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupWorkspacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupWorkspacesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, types.SetValueMust(types.StringType, nil), &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+// With the report mode, a warning lists the unmanaged workspaces.
+func (r *groupWorkspacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupWorkspacesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaces, found, serr := r.listGroupWorkspaces(ctx, state.WorkspaceGroupID.ValueString())
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace group got terminated externally, deleting the resource from the state file to recreate.
+	}
+
+	unmanaged, adopted := classifyWorkspaces(workspaces, setStrings(state.ManagedWorkspaceIDs), setStrings(state.AdoptedWorkspaceIDs))
+
+	result, diags := toGroupWorkspacesResourceModel(ctx, state, unmanaged, adopted)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if result.ManageExistingWorkspaces.ValueString() == manageExistingWorkspacesReport && len(unmanaged) > 0 {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Workspace group %s has unmanaged workspaces", state.WorkspaceGroupID.ValueString()),
+			fmt.Sprintf("The following workspaces are not managed by Terraform: %s. "+
+				"Import them, list their IDs in managed_workspace_ids, or set manage_existing_workspaces to '%s'.",
+				strings.Join(util.Map(unmanaged, describeWorkspace), ", "), manageExistingWorkspacesAdopt),
+		)
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupWorkspacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupWorkspacesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UnmanagedWorkspaceIDs.IsUnknown() && !plan.AdoptedWorkspaceIDs.IsUnknown() {
+		diags = resp.State.Set(ctx, &plan) // Adoption is bookkeeping only, the plan already shows the outcome.
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	var state groupWorkspacesResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, state.AdoptedWorkspaceIDs, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+// The adopted workspaces are terminated.
+func (r *groupWorkspacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupWorkspacesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adopted := setStrings(state.AdoptedWorkspaceIDs)
+	serr := inParallel(len(adopted), func(i int) *util.SummaryWithDetailError {
+		workspaceDeleteResponse, err := r.DeleteV1WorkspacesWorkspaceIDWithResponse(ctx, uuid.MustParse(adopted[i]))
+
+		return util.StatusOK(workspaceDeleteResponse, err, util.ReturnNilOnNotFound)
+	})
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *groupWorkspacesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// ModifyPlan plans the adoption or the release of the workspaces discovered by the latest refresh.
+func (r *groupWorkspacesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state *groupWorkspacesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+
+	var plan *groupWorkspacesResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	if plan.ManagedWorkspaceIDs.IsUnknown() || plan.ManageExistingWorkspaces.IsUnknown() {
+		plan.UnmanagedWorkspaceIDs = types.SetUnknown(types.StringType)
+		plan.AdoptedWorkspaceIDs = types.SetUnknown(types.StringType)
+	} else {
+		managed := setStrings(plan.ManagedWorkspaceIDs)
+		discovered := util.Filter(
+			append(setStrings(state.AdoptedWorkspaceIDs), setStrings(state.UnmanagedWorkspaceIDs)...),
+			func(id string) bool { return !util.Any(managed, id) },
+		)
+
+		unmanaged, adopted := discovered, []string{}
+		if plan.ManageExistingWorkspaces.ValueString() == manageExistingWorkspacesAdopt {
+			unmanaged, adopted = adopted, unmanaged
+		}
+
+		plan.UnmanagedWorkspaceIDs, diags = stringSet(ctx, unmanaged)
+		resp.Diagnostics.Append(diags...)
+		plan.AdoptedWorkspaceIDs, diags = stringSet(ctx, adopted)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply discovers the workspaces and sets the state, adopting the unmanaged ones in the adopt mode.
+func (r *groupWorkspacesResource) apply(ctx context.Context, plan groupWorkspacesResourceModel, previouslyAdopted types.Set, state *tfsdk.State, diagnostics *diag.Diagnostics) {
+	workspaces, found, serr := r.listGroupWorkspaces(ctx, plan.WorkspaceGroupID.ValueString())
+	if serr != nil {
+		diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !found {
+		diagnostics.AddError(
+			fmt.Sprintf("Workspace group %s is not found", plan.WorkspaceGroupID.ValueString()),
+			"Please ensure that the workspace group exists before discovering its workspaces.",
+		)
+
+		return
+	}
+
+	unmanaged, adopted := classifyWorkspaces(workspaces, setStrings(plan.ManagedWorkspaceIDs), setStrings(previouslyAdopted))
+	if plan.ManageExistingWorkspaces.ValueString() == manageExistingWorkspacesAdopt {
+		adopted = append(adopted, unmanaged...)
+		unmanaged = nil
+	} else {
+		unmanaged = append(unmanaged, adopted...)
+		adopted = nil
+	}
+
+	result, diags := toGroupWorkspacesResourceModel(ctx, plan, unmanaged, adopted)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	diags = state.Set(ctx, &result)
+	diagnostics.Append(diags...)
+}
+
+// listGroupWorkspaces lists the workspaces of the workspace group that are not terminated.
+// It returns false if the workspace group is not found.
+func (r *groupWorkspacesResource) listGroupWorkspaces(ctx context.Context, workspaceGroupID string) ([]management.Workspace, bool, *util.SummaryWithDetailError) {
+	workspaces, err := r.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{
+		WorkspaceGroupID: uuid.MustParse(workspaceGroupID),
+	})
+	if serr := util.StatusOK(workspaces, err, util.ReturnNilOnNotFound); serr != nil {
+		return nil, false, serr
+	}
+
+	if workspaces.JSON200 == nil {
+		return nil, false, nil
+	}
+
+	return util.Filter(*workspaces.JSON200, func(w management.Workspace) bool {
+		return w.State != management.WorkspaceStateTERMINATED
+	}), true, nil
+}
+
+// classifyWorkspaces splits the workspaces that are not managed into the previously adopted and the rest.
+// The adopted workspaces that no longer exist are dropped.
+func classifyWorkspaces(workspaces []management.Workspace, managed, previouslyAdopted []string) ([]management.Workspace, []management.Workspace) {
+	unmanaged := []management.Workspace{}
+	adopted := []management.Workspace{}
+	for _, w := range workspaces {
+		id := w.WorkspaceID.String()
+		switch {
+		case util.Any(managed, id):
+		case util.Any(previouslyAdopted, id):
+			adopted = append(adopted, w)
+		default:
+			unmanaged = append(unmanaged, w)
+		}
+	}
+
+	return unmanaged, adopted
+}
+
+func toGroupWorkspacesResourceModel(ctx context.Context, model groupWorkspacesResourceModel, unmanaged, adopted []management.Workspace) (groupWorkspacesResourceModel, diag.Diagnostics) {
+	result := model
+	result.ID = model.WorkspaceGroupID
+
+	var diags diag.Diagnostics
+	result.UnmanagedWorkspaceIDs, diags = stringSet(ctx, util.Map(unmanaged, workspaceID))
+	if diags.HasError() {
+		return result, diags
+	}
+
+	result.AdoptedWorkspaceIDs, diags = stringSet(ctx, util.Map(adopted, workspaceID))
+
+	return result, diags
+}
+
+func workspaceID(w management.Workspace) string {
+	return w.WorkspaceID.String()
+}
+
+func describeWorkspace(w management.Workspace) string {
+	return fmt.Sprintf("%s (%s)", w.Name, w.WorkspaceID)
+}
+
+// setStrings returns the string elements of the set.
+func setStrings(set types.Set) []string {
+	result := make([]string, 0, len(set.Elements()))
+	for _, e := range set.Elements() {
+		if s, ok := e.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+
+	return result
+}
+
+func stringSet(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	result := append([]string{}, values...)
+	sort.Strings(result)
+
+	return types.SetValueFrom(ctx, types.StringType, result)
+}
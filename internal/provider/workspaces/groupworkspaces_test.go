@@ -0,0 +1,192 @@
+package workspaces_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestWorkspaceGroupWorkspacesReportAndAdopt(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		AllowAllTraffic:  util.Ptr(false),
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestInitialFirewallRange}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	mu := sync.Mutex{}
+	workspaces := map[uuid.UUID]management.Workspace{}
+	addWorkspace := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		id := uuid.New()
+		workspaces[id] = management.Workspace{
+			CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+			Endpoint:         util.Ptr(id.String() + ".svc.singlestore.com"),
+			Name:             name,
+			Size:             config.TestInitialWorkspaceSize,
+			State:            management.WorkspaceStateACTIVE,
+			WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+			WorkspaceID:      id,
+		}
+	}
+
+	addWorkspace("portal-1") // Created in the portal before Terraform manages the workspace group.
+
+	writeJSON := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(body))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		workspaceGroupPath := strings.Join([]string{"/v1/workspaceGroups", workspaceGroup.WorkspaceGroupID.String()}, "/")
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			writeJSON(w, regions)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID})
+		case r.URL.Path == workspaceGroupPath && r.Method == http.MethodGet:
+			writeJSON(w, workspaceGroup)
+		case r.URL.Path == workspaceGroupPath && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			writeJSON(w, struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID})
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodGet:
+			require.Equal(t, workspaceGroup.WorkspaceGroupID.String(), r.URL.Query().Get("workspaceGroupID"))
+			result := []management.Workspace{}
+			for _, workspace := range workspaces {
+				result = append(result, workspace)
+			}
+
+			sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+			writeJSON(w, result)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			var input management.PostV1WorkspacesJSONRequestBody
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+
+			id := uuid.New()
+			workspaces[id] = management.Workspace{
+				CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+				Endpoint:         util.Ptr(id.String() + ".svc.singlestore.com"),
+				Name:             input.Name,
+				Size:             util.Deref(input.Size),
+				State:            management.WorkspaceStateACTIVE,
+				WorkspaceGroupID: input.WorkspaceGroupID,
+				WorkspaceID:      id,
+			}
+			writeJSON(w, struct{ WorkspaceID uuid.UUID }{WorkspaceID: id})
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/"):
+			id := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/v1/workspaces/"))
+			workspace, ok := workspaces[id]
+			require.True(t, ok, "unknown workspace %s", id)
+
+			if r.Method == http.MethodDelete {
+				workspace.State = management.WorkspaceStateTERMINATED
+				workspaces[id] = workspace
+				writeJSON(w, struct{ WorkspaceID uuid.UUID }{WorkspaceID: id})
+
+				return
+			}
+
+			require.Equal(t, http.MethodGet, r.Method)
+			writeJSON(w, workspace)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	activeNames := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result := []string{}
+		for _, workspace := range workspaces {
+			if workspace.State != management.WorkspaceStateTERMINATED {
+				result = append(result, workspace.Name)
+			}
+		}
+
+		sort.Strings(result)
+
+		return result
+	}
+
+	adoptConfig := testutil.UpdatableConfig(examples.WorkspaceGroupWorkspacesResource).
+		WithWorkspaceGroupWorkspacesResource("this")("manage_existing_workspaces", cty.StringVal("adopt")).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupWorkspacesResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", config.IDAttribute, workspaceGroup.WorkspaceGroupID.String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "manage_existing_workspaces", "report"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "unmanaged_workspace_ids.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "adopted_workspace_ids.#", "0"),
+				),
+			},
+			{
+				Config: adoptConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "unmanaged_workspace_ids.#", "0"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "adopted_workspace_ids.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					addWorkspace("portal-2")
+				},
+				Config: adoptConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "unmanaged_workspace_ids.#", "0"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_workspaces.this", "adopted_workspace_ids.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, []string{"portal-1", "portal-2", "workspace"}, activeNames(), "adopting should not change the workspaces")
+				},
+				Config: adoptConfig,
+			},
+		},
+	})
+
+	require.Empty(t, activeNames(), "destroying the resource should terminate the adopted workspaces")
+}
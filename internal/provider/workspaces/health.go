@@ -0,0 +1,204 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	DataSourceHealthName = "workspace_health"
+
+	healthStatusHealthy     = "healthy"
+	healthStatusNotActive   = "not_active"
+	healthStatusUnreachable = "unreachable"
+
+	// mysqlProtocolVersion is the first byte of the greeting that a MySQL compatible server sends on connect.
+	mysqlProtocolVersion = 10
+)
+
+// workspaceHealthDataSource is the data source implementation.
+type workspaceHealthDataSource struct {
+	util.ProviderData
+}
+
+// workspaceHealthDataSourceModel maps the data source schema data.
+type workspaceHealthDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Port      types.Int64  `tfsdk:"port"`
+	Healthy   types.Bool   `tfsdk:"healthy"`
+	Status    types.String `tfsdk:"status"`
+	State     types.String `tfsdk:"state"`
+	LatencyMS types.Int64  `tfsdk:"latency_ms"`
+	Message   types.String `tfsdk:"message"`
+}
+
+var _ datasource.DataSourceWithConfigure = &workspaceHealthDataSource{}
+
+// NewDataSourceHealth is a helper function to simplify the provider implementation.
+func NewDataSourceHealth() datasource.DataSource {
+	return &workspaceHealthDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.DataSourceTypeName(req, DataSourceHealthName)
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Check that the SQL endpoint of a workspace accepts connections with this data source. The check connects to the endpoint and waits for the server greeting without authenticating. An unhealthy workspace is reported in the attributes rather than as an error, so that a postcondition can gate the dependent resources.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workspace.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The port to check. Defaults to %d, the SQL port of the workspace.", config.WorkspaceSQLPort),
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the workspace is active and its endpoint responded with a server greeting.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The result of the check, one of '%s', '%s', or '%s'.", healthStatusHealthy, healthStatusNotActive, healthStatusUnreachable),
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the workspace according to the Management API.",
+			},
+			"latency_ms": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The time in milliseconds until the server greeting arrived. Null if the endpoint was not reached.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reason the workspace is not healthy, or an empty string.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workspaceHealthDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(config.IDAttribute),
+			"Invalid workspace ID",
+			"The workspace ID should be a valid UUID",
+		)
+
+		return
+	}
+
+	workspace, err := d.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	result := workspaceHealthDataSourceModel{
+		ID:        data.ID,
+		Port:      types.Int64Value(config.WorkspaceSQLPort),
+		Healthy:   types.BoolValue(false),
+		State:     types.StringValue(string(workspace.JSON200.State)),
+		LatencyMS: types.Int64Null(),
+	}
+
+	if !data.Port.IsNull() {
+		result.Port = data.Port
+	}
+
+	switch {
+	case workspace.JSON200.State != management.WorkspaceStateACTIVE || workspace.JSON200.Endpoint == nil:
+		result.Status = types.StringValue(healthStatusNotActive)
+		result.Message = types.StringValue(fmt.Sprintf("workspace %s state is %s while it should be %s", id, workspace.JSON200.State, management.WorkspaceStateACTIVE))
+	default:
+		address := net.JoinHostPort(*workspace.JSON200.Endpoint, strconv.FormatInt(result.Port.ValueInt64(), 10))
+		latency, err := probeGreeting(ctx, address, config.WorkspaceHealthCheckTimeout)
+		if err != nil {
+			result.Status = types.StringValue(healthStatusUnreachable)
+			result.Message = types.StringValue(err.Error())
+
+			break
+		}
+
+		result.Healthy = types.BoolValue(true)
+		result.Status = types.StringValue(healthStatusHealthy)
+		result.LatencyMS = types.Int64Value(latency.Milliseconds())
+		result.Message = types.StringValue("")
+	}
+
+	diags = resp.State.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// probeGreeting connects to the address and reads the header of the initial handshake packet.
+func probeGreeting(ctx context.Context, address string, timeout time.Duration) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetReadDeadline(deadline); err != nil {
+			return 0, fmt.Errorf("failed to set the read deadline for %s: %w", address, err)
+		}
+	}
+
+	header := make([]byte, 5) // 3 bytes of the payload length, 1 byte of the sequence ID, and the protocol version.
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return 0, fmt.Errorf("failed to read the server greeting from %s: %w", address, err)
+	}
+
+	if header[4] != mysqlProtocolVersion {
+		return 0, fmt.Errorf("the endpoint %s did not respond with a SQL server greeting", address)
+	}
+
+	return time.Since(start), nil
+}
@@ -0,0 +1,118 @@
+package workspaces_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestReadsWorkspaceHealth(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_, _ = conn.Write([]byte{0x4a, 0x00, 0x00, 0x00, 10}) // The beginning of a server greeting.
+			conn.Close()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Endpoint:         util.Ptr(host),
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		w.Header().Add("Content-Type", "json") // Necessary to make the library parse the resulting JSON.
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceHealthDataSource).
+					WithWorkspaceHealthDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					WithWorkspaceHealthDataSource("this")("port", cty.NumberIntVal(int64(portNumber))).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", config.IDAttribute, workspace.WorkspaceID.String()),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", "port", port),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", "status", "healthy"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", "state", string(management.WorkspaceStateACTIVE)),
+					resource.TestCheckResourceAttrSet("data.singlestoredb_workspace_health.this", "latency_ms"),
+					resource.TestCheckResourceAttr("data.singlestoredb_workspace_health.this", "message", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceHealthReportsSuspendedWorkspace(t *testing.T) {
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06.3003Z",
+		Name:             "foo",
+		Size:             "S-00",
+		State:            management.WorkspaceStateSUSPENDED,
+		WorkspaceGroupID: uuid.MustParse("883b6d19-1e2f-4d29-9e06-5c5d0ebc4b8b"),
+		WorkspaceID:      uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/v1/workspaces/%s", workspace.WorkspaceID), r.URL.Path)
+		w.Header().Add("Content-Type", "json")
+		_, err := w.Write(testutil.MustJSON(workspace))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspaceHealthDataSource).
+					WithWorkspaceHealthDataSource("this")(config.IDAttribute, cty.StringVal(workspace.WorkspaceID.String())).
+					String(),
+				ExpectError: regexp.MustCompile("The workspace is not reachable"),
+			},
+		},
+	})
+}
@@ -0,0 +1,93 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// healthChecksResourceModel maps the health checks of the workspace resource.
+type healthChecksResourceModel struct {
+	RequireActive             types.Bool  `tfsdk:"require_active"`
+	RequireEndpointResolvable types.Bool  `tfsdk:"require_endpoint_resolvable"`
+	MaxDaysSinceResumed       types.Int64 `tfsdk:"max_days_since_resumed"`
+}
+
+func newHealthChecksResourceSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "The checks that every refresh evaluates against the workspace. A failed check is reported as a warning rather than an error, so that a plain plan serves as a health report of the workspaces without blocking the apply. The checks do not affect the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"require_active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("If true, warn unless the state of the workspace is %s.", management.WorkspaceStateACTIVE),
+			},
+			"require_endpoint_resolvable": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If true, warn unless the endpoint of the workspace resolves in DNS from the machine running Terraform.",
+			},
+			"max_days_since_resumed": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Warn if the workspace was last resumed, or created if it was never resumed, more than this number of days ago.",
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+		},
+	}
+}
+
+// failedHealthChecks returns the reasons why the workspace fails the checks.
+func failedHealthChecks(ctx context.Context, checks *healthChecksResourceModel, workspace management.Workspace, now time.Time) []string {
+	if checks == nil {
+		return nil
+	}
+
+	result := []string{}
+	if checks.RequireActive.ValueBool() && workspace.State != management.WorkspaceStateACTIVE {
+		result = append(result, fmt.Sprintf("the state is %s while it should be %s", workspace.State, management.WorkspaceStateACTIVE))
+	}
+
+	if checks.RequireEndpointResolvable.ValueBool() {
+		if reason := unresolvableEndpoint(ctx, workspace.Endpoint); reason != "" {
+			result = append(result, reason)
+		}
+	}
+
+	if !checks.MaxDaysSinceResumed.IsNull() {
+		since := workspace.CreatedAt
+		if workspace.LastResumedAt != nil {
+			since = *workspace.LastResumedAt
+		}
+
+		if t, err := time.Parse(time.RFC3339, since); err == nil {
+			days := int64(now.Sub(t) / (24 * time.Hour))
+			if days > checks.MaxDaysSinceResumed.ValueInt64() {
+				result = append(result, fmt.Sprintf("the workspace was last resumed %d days ago at %s, more than %d days ago", days, since, checks.MaxDaysSinceResumed.ValueInt64()))
+			}
+		}
+	}
+
+	return result
+}
+
+func unresolvableEndpoint(ctx context.Context, endpoint *string) string {
+	if endpoint == nil {
+		return "the workspace has no endpoint"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.WorkspaceHealthCheckTimeout)
+	defer cancel()
+
+	if _, err := net.DefaultResolver.LookupHost(ctx, *endpoint); err != nil {
+		return fmt.Sprintf("the endpoint %s does not resolve: %s", *endpoint, err)
+	}
+
+	return ""
+}
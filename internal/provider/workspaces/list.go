@@ -19,7 +19,7 @@ const (
 
 // workspacesDataSourceList is the data source implementation.
 type workspacesDataSourceList struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspacesListDataSourceModel maps the data source schema data.
@@ -120,5 +120,5 @@ func (d *workspacesDataSourceList) Configure(_ context.Context, req datasource.C
 		return // Should not return an error for unknown reasons.
 	}
 
-	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.ProviderData = req.ProviderData.(util.ProviderData)
 }
@@ -0,0 +1,314 @@
+package workspaces
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourcePauseName = "workspace_group_pause"
+)
+
+var _ resource.ResourceWithConfigure = &workspaceGroupPauseResource{}
+
+// workspaceGroupPauseResource is the resource implementation.
+type workspaceGroupPauseResource struct {
+	util.ProviderData
+}
+
+// workspaceGroupPauseResourceModel maps the resource schema data.
+type workspaceGroupPauseResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	WorkspaceGroupID      types.String   `tfsdk:"workspace_group_id"`
+	Suspended             types.Bool     `tfsdk:"suspended"`
+	SuspendedWorkspaceIDs []types.String `tfsdk:"suspended_workspace_ids"`
+}
+
+// NewResourcePause is a helper function to simplify the provider implementation.
+func NewResourcePause() resource.Resource {
+	return &workspaceGroupPauseResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceGroupPauseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourcePauseName)
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceGroupPauseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Suspend all the active workspaces of a workspace group with one switch, e.g., to freeze a non-production environment and save costs. Destroying the resource or setting suspended to false resumes the workspaces that the resource suspended; the workspaces that were already suspended stay suspended. The workspaces are suspended and resumed concurrently.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the workspace group.",
+			},
+			config.WorkspaceGroupIDAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique identifier of the workspace group whose workspaces to suspend.",
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
+			"suspended": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, the active workspaces of the workspace group are suspended. Setting it to false resumes them without destroying the resource. Defaults to true.",
+			},
+			"suspended_workspace_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The unique identifiers of the workspaces that the resource suspended and resumes on destroy or when suspended is set to false.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workspaceGroupPauseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceGroupPauseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.WorkspaceGroupID
+	plan.SuspendedWorkspaceIDs = []types.String{}
+
+	if plan.Suspended.ValueBool() {
+		ids, serr := r.suspendActive(ctx, plan)
+		if serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		plan.SuspendedWorkspaceIDs = ids
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+// The workspaces that got terminated since are no longer tracked.
+func (r *workspaceGroupPauseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceGroupPauseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceList, found, serr := r.list(ctx, state)
+	if serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return // The workspace group got terminated externally, deleting the resource from the state file.
+	}
+
+	existing := map[string]bool{}
+	for _, w := range workspaceList {
+		if w.State != management.WorkspaceStateTERMINATED {
+			existing[w.WorkspaceID.String()] = true
+		}
+	}
+
+	state.SuspendedWorkspaceIDs = util.Filter(state.SuspendedWorkspaceIDs, func(id types.String) bool {
+		return existing[id.ValueString()]
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workspaceGroupPauseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state workspaceGroupPauseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan workspaceGroupPauseResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SuspendedWorkspaceIDs = state.SuspendedWorkspaceIDs
+
+	switch {
+	case plan.Suspended.ValueBool() && !state.Suspended.ValueBool():
+		ids, serr := r.suspendActive(ctx, plan)
+		if serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		plan.SuspendedWorkspaceIDs = ids
+	case !plan.Suspended.ValueBool() && state.Suspended.ValueBool():
+		if serr := r.resumeSuspended(ctx, state); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
+
+			return
+		}
+
+		plan.SuspendedWorkspaceIDs = []types.String{}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workspaceGroupPauseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceGroupPauseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serr := r.resumeSuspended(ctx, state); serr != nil {
+		resp.Diagnostics.AddError(
+			serr.Summary,
+			serr.Detail,
+		)
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceGroupPauseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderData)
+}
+
+// list lists the workspaces of the workspace group. It returns false if the workspace group is not found.
+func (r *workspaceGroupPauseResource) list(ctx context.Context, model workspaceGroupPauseResourceModel) ([]management.Workspace, bool, *util.SummaryWithDetailError) {
+	workspaceList, err := r.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{
+		WorkspaceGroupID: uuid.MustParse(model.WorkspaceGroupID.ValueString()),
+	})
+	if serr := util.StatusOK(workspaceList, err, util.ReturnNilOnNotFound); serr != nil {
+		return nil, false, serr
+	}
+
+	if workspaceList.JSON200 == nil {
+		return nil, false, nil
+	}
+
+	return *workspaceList.JSON200, true, nil
+}
+
+// suspendActive suspends the active workspaces of the workspace group and returns their IDs.
+func (r *workspaceGroupPauseResource) suspendActive(ctx context.Context, model workspaceGroupPauseResourceModel) ([]types.String, *util.SummaryWithDetailError) {
+	workspaceList, _, serr := r.list(ctx, model)
+	if serr != nil {
+		return nil, serr
+	}
+
+	active := util.Filter(workspaceList, func(w management.Workspace) bool {
+		return w.State == management.WorkspaceStateACTIVE
+	})
+
+	serr = inParallel(len(active), func(i int) *util.SummaryWithDetailError {
+		id := active[i].WorkspaceID
+		workspaceSuspendResponse, err := r.PostV1WorkspacesWorkspaceIDSuspendWithResponse(ctx, id)
+		if serr := util.StatusOK(workspaceSuspendResponse, err); serr != nil {
+			return serr
+		}
+
+		_, werr := wait(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceResumeTimeout,
+			waitConditionState(management.WorkspaceStateSUSPENDED),
+		)
+
+		return werr
+	})
+	if serr != nil {
+		return nil, serr
+	}
+
+	result := util.Map(active, func(w management.Workspace) types.String {
+		return util.UUIDStringValue(w.WorkspaceID)
+	})
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ValueString() < result[j].ValueString() })
+
+	return result, nil
+}
+
+// resumeSuspended resumes the workspaces that the resource suspended if they are still suspended.
+func (r *workspaceGroupPauseResource) resumeSuspended(ctx context.Context, model workspaceGroupPauseResourceModel) *util.SummaryWithDetailError {
+	workspaceList, _, serr := r.list(ctx, model)
+	if serr != nil {
+		return serr
+	}
+
+	tracked := map[string]bool{}
+	for _, id := range model.SuspendedWorkspaceIDs {
+		tracked[id.ValueString()] = true
+	}
+
+	suspended := util.Filter(workspaceList, func(w management.Workspace) bool {
+		return tracked[w.WorkspaceID.String()] && w.State == management.WorkspaceStateSUSPENDED
+	})
+
+	return inParallel(len(suspended), func(i int) *util.SummaryWithDetailError {
+		id := suspended[i].WorkspaceID
+		workspaceResumeResponse, err := r.PostV1WorkspacesWorkspaceIDResumeWithResponse(ctx, id)
+		if serr := util.StatusOK(workspaceResumeResponse, err); serr != nil {
+			return serr
+		}
+
+		_, werr := wait(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceResumeTimeout,
+			waitConditionState(management.WorkspaceStateACTIVE),
+		)
+
+		return werr
+	})
+}
@@ -0,0 +1,134 @@
+package workspaces_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestWorkspaceGroupPause(t *testing.T) {
+	workspaceGroupID := uuid.MustParse("bc8c0deb-50dd-4a58-a5a5-1c62eb5c456d")
+	activeIDs := []uuid.UUID{
+		uuid.MustParse("a2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+		uuid.MustParse("b2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+	alreadySuspendedID := uuid.MustParse("c2a1a960-8591-4156-bb26-f53f0f8e35ce")
+
+	mu := sync.Mutex{}
+	states := map[uuid.UUID]management.WorkspaceState{
+		activeIDs[0]:       management.WorkspaceStateACTIVE,
+		activeIDs[1]:       management.WorkspaceStateACTIVE,
+		alreadySuspendedID: management.WorkspaceStateSUSPENDED,
+	}
+
+	toWorkspace := func(id uuid.UUID) management.Workspace {
+		return management.Workspace{
+			CreatedAt:        "2023-02-28T05:33:06.3003Z",
+			Name:             id.String(),
+			Size:             "S-00",
+			State:            states[id],
+			WorkspaceGroupID: workspaceGroupID,
+			WorkspaceID:      id,
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Add("Content-Type", "json")
+
+		if r.URL.Path == "/v1/workspaces" {
+			require.Equal(t, http.MethodGet, r.Method)
+			require.Equal(t, workspaceGroupID.String(), r.URL.Query().Get("workspaceGroupID"))
+			_, err := w.Write(testutil.MustJSON([]management.Workspace{
+				toWorkspace(activeIDs[0]),
+				toWorkspace(activeIDs[1]),
+				toWorkspace(alreadySuspendedID),
+			}))
+			require.NoError(t, err)
+
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/workspaces/"), "/")
+		id := uuid.MustParse(parts[0])
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(toWorkspace(id)))
+			require.NoError(t, err)
+		case len(parts) == 2 && parts[1] == "suspend" && r.Method == http.MethodPost:
+			require.Equal(t, management.WorkspaceStateACTIVE, states[id], "should suspend only the active workspaces")
+			states[id] = management.WorkspaceStateSUSPENDED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: id}))
+			require.NoError(t, err)
+		case len(parts) == 2 && parts[1] == "resume" && r.Method == http.MethodPost:
+			require.NotEqual(t, alreadySuspendedID, id, "should not resume the workspace that was suspended before")
+			states[id] = management.WorkspaceStateACTIVE
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: id}))
+			require.NoError(t, err)
+		default:
+			require.Fail(t, "unexpected request", "%s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	state := func(id uuid.UUID) management.WorkspaceState {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return states[id]
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspaceGroupPauseResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended", "true"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended_workspace_ids.#", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended_workspace_ids.0", activeIDs[0].String()),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended_workspace_ids.1", activeIDs[1].String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, management.WorkspaceStateSUSPENDED, state(activeIDs[0]))
+					require.Equal(t, management.WorkspaceStateSUSPENDED, state(activeIDs[1]))
+				},
+				Config: testutil.UpdatableConfig(examples.WorkspaceGroupPauseResource).
+					WithWorkspaceGroupPauseResource("this")("suspended", cty.False).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended", "false"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended_workspace_ids.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.Equal(t, management.WorkspaceStateACTIVE, state(activeIDs[0]))
+					require.Equal(t, management.WorkspaceStateACTIVE, state(activeIDs[1]))
+				},
+				Config: examples.WorkspaceGroupPauseResource,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace_group_pause.this", "suspended_workspace_ids.#", "2"),
+			},
+		},
+	})
+
+	require.Equal(t, management.WorkspaceStateACTIVE, state(activeIDs[0]), "destroy should resume the workspaces")
+	require.Equal(t, management.WorkspaceStateACTIVE, state(activeIDs[1]), "destroy should resume the workspaces")
+	require.Equal(t, management.WorkspaceStateSUSPENDED, state(alreadySuspendedID))
+}
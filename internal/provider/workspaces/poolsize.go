@@ -0,0 +1,41 @@
+package workspaces
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// vCPUs returns the count of the vCPUs of the workspace size,
+// where S-00 has 2 vCPUs, S-0 has 4 vCPUs, and S-N has 8 vCPUs per unit.
+func vCPUs(size string) (int64, bool) {
+	switch size {
+	case "S-00":
+		return 2, true
+	case "S-0":
+		return 4, true
+	}
+
+	units, err := strconv.ParseInt(strings.TrimPrefix(size, "S-"), 10, 64)
+	if err != nil || units < 1 || !strings.HasPrefix(size, "S-") {
+		return 0, false
+	}
+
+	return 8 * units, true
+}
+
+// recommendedConnectionPoolSizeValue returns the recommended maximum size of a connection pool of an application for the workspace size.
+func recommendedConnectionPoolSizeValue(size types.String) types.Int64 {
+	if size.IsUnknown() {
+		return types.Int64Unknown()
+	}
+
+	n, ok := vCPUs(size.ValueString())
+	if !ok {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(n * config.WorkspaceConnectionsPerVCPU)
+}
@@ -3,18 +3,21 @@ package workspaces
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/dataapi"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
 
@@ -30,18 +33,26 @@ var (
 
 // workspaceResource is the resource implementation.
 type workspaceResource struct {
-	management.ClientWithResponsesInterface
+	util.ProviderData
 }
 
 // workspaceResourceModel maps the resource schema data.
 type workspaceResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	WorkspaceGroupID types.String `tfsdk:"workspace_group_id"`
-	Name             types.String `tfsdk:"name"`
-	Size             types.String `tfsdk:"size"`
-	Suspended        types.Bool   `tfsdk:"suspended"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	Endpoint         types.String `tfsdk:"endpoint"`
+	ID                 types.String               `tfsdk:"id"`
+	WorkspaceGroupID   types.String               `tfsdk:"workspace_group_id"`
+	Name               types.String               `tfsdk:"name"`
+	Size               types.String               `tfsdk:"size"`
+	Suspended          types.Bool                 `tfsdk:"suspended"`
+	KaiEnabled         types.Bool                 `tfsdk:"kai_enabled"`
+	CreatedAt          types.String               `tfsdk:"created_at"`
+	Endpoint           types.String               `tfsdk:"endpoint"`
+	DataAPIURL         types.String               `tfsdk:"data_api_url"`
+	ConnectionPoolSize types.Int64                `tfsdk:"recommended_connection_pool_size"`
+	WaitForCreation    types.Bool                 `tfsdk:"wait_for_creation"`
+	WaitForTermination types.Bool                 `tfsdk:"wait_for_termination"`
+	ResizeTimeout      types.String               `tfsdk:"resize_timeout"`
+	Timeouts           *util.Timeouts             `tfsdk:"timeouts"`
+	HealthChecks       *healthChecksResourceModel `tfsdk:"health_checks"`
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -83,7 +94,16 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"suspended": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The status of the workspace. If true, the workspace is suspended.",
+				MarkdownDescription: "The status of the workspace. If true, the workspace is suspended. Setting it to true suspends the workspace and setting it to false resumes it, waiting until the workspace is SUSPENDED or ACTIVE respectively. A workspace created with true is suspended once it is active.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"kai_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "If true, SingleStore Kai, the MongoDB API, is enabled on creation, so that the MongoDB drivers can connect to the workspace. Changing it recreates the workspace. Defaults to false.",
 				Default:             booldefault.StaticBool(false),
 			},
 			"created_at": schema.StringAttribute{
@@ -97,6 +117,33 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "The endpoint used to connect to the workspace.",
 			},
+			"data_api_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The base URL of the SingleStore Data API of the workspace for running SQL statements over HTTPS, e.g., from serverless applications. It is not set while the workspace is suspended.",
+			},
+			"recommended_connection_pool_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The recommended maximum size of the connection pool of an application connecting to the workspace, derived from the vCPUs of the size at %d connections per vCPU, e.g., 8 for S-00. Use it to template the pool settings of the applications, and lower it if several applications share the workspace.", config.WorkspaceConnectionsPerVCPU),
+			},
+			"wait_for_creation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, creating the workspace waits until the workspace is active. If false, creating returns once the Management API accepts the creation, e.g., to fan out many environments and poll their readiness separately, and refreshing tolerates the PENDING state. Setting it to false conflicts with suspended set to true, which requires an active workspace. Defaults to true.",
+			},
+			"wait_for_termination": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, destroying the workspace waits until the workspace is terminated. If false, destroying returns once the Management API accepts the termination, e.g., to tear down large ephemeral environments quickly. Defaults to true.",
+			},
+			"resize_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf(`How long changing the size waits for the workspace to become active with the new size as a duration, e.g., "12h". The workspace is resized in place, and the states that it goes through are logged as they change and reported if the resize fails or times out. Takes precedence over the update timeout. Defaults to %q.`, config.WorkspaceResizeTimeout),
+				Validators:          []validator.String{util.NewDurationValidator()},
+			},
+			"health_checks": newHealthChecksResourceSchemaAttribute(),
+			"timeouts":      util.TimeoutsSchemaAttribute(config.WorkspaceCreationTimeout, config.WorkspaceResizeTimeout, config.WorkspaceTerminationTimeout),
 		},
 	}
 }
@@ -110,20 +157,21 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	if plan.Suspended.ValueBool() {
+	if !plan.WaitForCreation.ValueBool() && plan.Suspended.ValueBool() {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("suspended"),
-			"Cannot suspend a workspace during creation",
-			"Either set value to false or omit the field.",
+			path.Root("wait_for_creation"),
+			"Cannot suspend a workspace without waiting for the creation",
+			"A workspace is suspended once it is active. Either set wait_for_creation to true or set suspended to false.",
 		)
 
 		return
 	}
 
 	workspaceCreateResponse, err := r.PostV1WorkspacesWithResponse(ctx, management.PostV1WorkspacesJSONRequestBody{
-		Name:             plan.Name.ValueString(),
-		Size:             util.MaybeString(plan.Size),
-		WorkspaceGroupID: uuid.MustParse(plan.WorkspaceGroupID.String()),
+		Name:               plan.Name.ValueString(),
+		Size:               util.MaybeString(plan.Size),
+		StargateAttributes: toStargateAttributes(plan.KaiEnabled),
+		WorkspaceGroupID:   uuid.MustParse(plan.WorkspaceGroupID.String()),
 	})
 	if serr := util.StatusOK(workspaceCreateResponse, err); serr != nil {
 		resp.Diagnostics.AddError(
@@ -134,9 +182,12 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	w, werr := wait(ctx, r.ClientWithResponsesInterface, workspaceCreateResponse.JSON200.WorkspaceID, config.WorkspaceCreationTimeout,
-		waitConditionState(management.WorkspaceStateACTIVE),
-	)
+	conditions := []waitCondition{waitConditionState(management.WorkspaceStateACTIVE)}
+	if !plan.WaitForCreation.ValueBool() {
+		conditions = nil // Getting the workspace once in whatever state it is.
+	}
+
+	w, werr := wait(ctx, r.ClientWithResponsesInterface, workspaceCreateResponse.JSON200.WorkspaceID, plan.Timeouts.CreateTimeout(config.WorkspaceCreationTimeout), conditions...)
 	if werr != nil {
 		resp.Diagnostics.AddError(
 			werr.Summary,
@@ -147,11 +198,33 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	result := toWorkspaceResourceModel(w)
+	if plan.Suspended.ValueBool() {
+		// The Management API creates active workspaces only.
+		result.Timeouts = plan.Timeouts
+		result, werr = suspend(ctx, r.ClientWithResponsesInterface, result)
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
+	}
+
+	result.KaiEnabled = plan.KaiEnabled
+	result.WaitForCreation = plan.WaitForCreation
+	result.WaitForTermination = plan.WaitForTermination
+	result.ResizeTimeout = plan.ResizeTimeout
+	result.Timeouts = plan.Timeouts
+	result.HealthChecks = plan.HealthChecks
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
+//
+// The health checks are evaluated here, so that every plan reports the failed ones as warnings.
 func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state workspaceResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -180,8 +253,9 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return // The resource got terminated externally, deleting it from the state file to recreate.
 	}
 
+	creating := workspace.JSON200.State == management.WorkspaceStatePENDING && !state.WaitForCreation.IsNull() && !state.WaitForCreation.ValueBool()
 	if workspace.JSON200.State != management.WorkspaceStateACTIVE &&
-		workspace.JSON200.State != management.WorkspaceStateSUSPENDED {
+		workspace.JSON200.State != management.WorkspaceStateSUSPENDED && !creating {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Workspace %s state is %s while it should be %s or %s", state.ID.ValueString(), workspace.JSON200.State, management.WorkspaceStateACTIVE, management.WorkspaceStateSUSPENDED),
 			"An unexpected workspace state.\n\n"+
@@ -191,8 +265,22 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state = toWorkspaceResourceModel(*workspace.JSON200)
-	diags = resp.State.Set(ctx, &state)
+	result := toWorkspaceResourceModel(*workspace.JSON200)
+	result.KaiEnabled = types.BoolValue(state.KaiEnabled.ValueBool())                                                      // Null after import.
+	result.WaitForCreation = types.BoolValue(state.WaitForCreation.IsNull() || state.WaitForCreation.ValueBool())          // Null after import.
+	result.WaitForTermination = types.BoolValue(state.WaitForTermination.IsNull() || state.WaitForTermination.ValueBool()) // Null after import.
+	result.ResizeTimeout = state.ResizeTimeout
+	result.Timeouts = state.Timeouts
+	result.HealthChecks = state.HealthChecks
+
+	for _, reason := range failedHealthChecks(ctx, state.HealthChecks, *workspace.JSON200, time.Now().UTC()) {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Workspace %s failed a health check", state.ID.ValueString()),
+			fmt.Sprintf("The workspace %q does not pass a check of health_checks: %s.", workspace.JSON200.Name, reason),
+		)
+	}
+
+	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,6 +314,13 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	state.KaiEnabled = plan.KaiEnabled
+	state.WaitForCreation = plan.WaitForCreation
+	state.WaitForTermination = plan.WaitForTermination
+	state.ResizeTimeout = plan.ResizeTimeout
+	state.Timeouts = plan.Timeouts
+	state.HealthChecks = plan.HealthChecks
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -242,7 +337,9 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	workspaceDeleteResponse, err := r.DeleteV1WorkspacesWorkspaceIDWithResponse(ctx, uuid.MustParse(state.ID.ValueString()))
+	id := uuid.MustParse(state.ID.ValueString())
+
+	workspaceDeleteResponse, err := r.DeleteV1WorkspacesWorkspaceIDWithResponse(ctx, id)
 	if serr := util.StatusOK(workspaceDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
 		resp.Diagnostics.AddError(
 			serr.Summary,
@@ -251,6 +348,19 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 
 		return
 	}
+
+	if !state.WaitForTermination.ValueBool() {
+		return
+	}
+
+	if werr := waitTerminated(ctx, r.ClientWithResponsesInterface, id, state.Timeouts.DeleteTimeout(config.WorkspaceTerminationTimeout)); werr != nil {
+		resp.Diagnostics.AddError(
+			werr.Summary,
+			werr.Detail,
+		)
+
+		return
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -259,7 +369,7 @@ func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureR
 		return // Should not return an error for unknown reasons.
 	}
 
-	r.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	r.ProviderData = req.ProviderData.(util.ProviderData)
 }
 
 // ModifyPlan emits an error if a required yet immutable field changes or if incompatible state is set.
@@ -301,6 +411,10 @@ func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 
 		return
 	}
+
+	plan.ConnectionPoolSize = recommendedConnectionPoolSizeValue(plan.Size)
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState results in Terraform managing the resource that was not previously managed.
@@ -310,16 +424,35 @@ func (r *workspaceResource) ImportState(ctx context.Context, req resource.Import
 
 func toWorkspaceResourceModel(workspace management.Workspace) workspaceResourceModel {
 	return workspaceResourceModel{
-		ID:               util.UUIDStringValue(workspace.WorkspaceID),
-		WorkspaceGroupID: util.UUIDStringValue(workspace.WorkspaceGroupID),
-		Name:             types.StringValue(workspace.Name),
-		Size:             types.StringValue(workspace.Size),
-		Suspended:        types.BoolValue(workspace.State == management.WorkspaceStateSUSPENDED),
-		CreatedAt:        types.StringValue(workspace.CreatedAt),
-		Endpoint:         util.MaybeStringValue(workspace.Endpoint),
+		ID:                 util.UUIDStringValue(workspace.WorkspaceID),
+		WorkspaceGroupID:   util.UUIDStringValue(workspace.WorkspaceGroupID),
+		Name:               types.StringValue(workspace.Name),
+		Size:               types.StringValue(workspace.Size),
+		Suspended:          types.BoolValue(workspace.State == management.WorkspaceStateSUSPENDED),
+		CreatedAt:          types.StringValue(workspace.CreatedAt),
+		Endpoint:           util.MaybeStringValue(workspace.Endpoint),
+		DataAPIURL:         dataAPIURLValue(workspace.Endpoint),
+		ConnectionPoolSize: recommendedConnectionPoolSizeValue(types.StringValue(workspace.Size)),
 	}
 }
 
+// toStargateAttributes returns the features of the workspace that are enabled on creation.
+func toStargateAttributes(kaiEnabled types.Bool) *[]management.StargateAttribute {
+	if !kaiEnabled.ValueBool() {
+		return nil
+	}
+
+	return &[]management.StargateAttribute{management.MongoProxy}
+}
+
+func dataAPIURLValue(endpoint *string) types.String {
+	if endpoint == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(dataapi.URL(*endpoint))
+}
+
 func isValidSuspendedOrSizeChange(state, plan *workspaceResourceModel) *util.SummaryWithDetailError {
 	sizeChanged := !plan.Size.Equal(state.Size)
 	suspendedChanged := !plan.Suspended.Equal(state.Suspended)
@@ -2,11 +2,14 @@ package workspaces_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -221,6 +224,7 @@ func TestCRUDWorkspace(t *testing.T) { //nolint:cyclop,maintidx
 			},
 		))
 		require.NoError(t, err)
+		workspaceGroup.State = management.TERMINATED // Ending the termination polling.
 	}
 
 	workspacesDeleteHandler := func(w http.ResponseWriter, r *http.Request) {
@@ -236,6 +240,7 @@ func TestCRUDWorkspace(t *testing.T) { //nolint:cyclop,maintidx
 			},
 		))
 		require.NoError(t, err)
+		workspace.State = management.WorkspaceStateTERMINATED // Ending the termination polling.
 	}
 
 	readOnlyHandlers := []func(w http.ResponseWriter, r *http.Request) bool{
@@ -282,9 +287,11 @@ func TestCRUDWorkspace(t *testing.T) { //nolint:cyclop,maintidx
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "workspace_group_id", workspace.WorkspaceGroupID.String()),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "name", workspace.Name),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "size", workspace.Size),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "recommended_connection_pool_size", "8"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "suspended", "false"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "created_at", workspace.CreatedAt),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "endpoint", *workspace.Endpoint),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "data_api_url", "https://"+*workspace.Endpoint+"/api/v2"),
 					resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "last_resumed_at"),
 				),
 			},
@@ -296,6 +303,7 @@ func TestCRUDWorkspace(t *testing.T) { //nolint:cyclop,maintidx
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "size", workspace.Size),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "suspended", "true"),
 					resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "endpoint"),
+					resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "data_api_url"),
 				),
 			},
 			{
@@ -316,6 +324,7 @@ func TestCRUDWorkspace(t *testing.T) { //nolint:cyclop,maintidx
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "suspended", "false"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "size", updatedWorkspaceSize),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "recommended_connection_pool_size", "16"),
 					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "endpoint", *newEndpoint),
 				),
 			},
@@ -382,3 +391,554 @@ func TestWorkspaceResourceIntegration(t *testing.T) {
 		},
 	})
 }
+
+func TestWorkspaceHealthChecksOnlyWarn(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        "2023-02-28T05:33:06Z", // Never resumed since long ago.
+		Endpoint:         util.Ptr("svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com"),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodDelete:
+			workspace.State = management.WorkspaceStateTERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	checked := testutil.UpdatableConfig(examples.WorkspacesResource).
+		WithWorkspaceResource("this")("health_checks", cty.ObjectVal(map[string]cty.Value{
+		"require_active":         cty.True,
+		"max_days_since_resumed": cty.NumberIntVal(1), // Fails, yet only warns.
+	})).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: checked,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "health_checks.require_active", "true"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "health_checks.max_days_since_resumed", "1"),
+				),
+			},
+			{
+				Config:   checked,
+				PlanOnly: true,
+			},
+		},
+	})
+
+	require.Equal(t, management.WorkspaceStateTERMINATED, workspace.State)
+}
+
+func TestWorkspaceResizeTimeoutReportsStates(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Endpoint:         util.Ptr("svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com"),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodPatch:
+			patches++ // The size never changes as if the resize were stuck.
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodDelete:
+			workspace.State = management.WorkspaceStateTERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: examples.WorkspacesResource,
+				Check:  resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "resize_timeout"),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspacesResource).
+					WithWorkspaceResource("this")("size", cty.StringVal(updatedWorkspaceSize)).
+					WithWorkspaceResource("this")("resize_timeout", cty.StringVal("5s")).
+					String(),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("was observed as %s with size %s", management.WorkspaceStateACTIVE, config.TestInitialWorkspaceSize)),
+			},
+		},
+	})
+
+	require.Equal(t, 1, patches, "should resize in place")
+}
+
+func TestCreateSuspendedWorkspace(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Endpoint:         util.Ptr("svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com"),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	endpoint := workspace.Endpoint
+	suspends, resumes := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasSuffix(r.URL.Path, "/suspend") && r.Method == http.MethodPost:
+			suspends++
+			workspace.State = management.WorkspaceStateSUSPENDED
+			workspace.Endpoint = nil
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasSuffix(r.URL.Path, "/resume") && r.Method == http.MethodPost:
+			resumes++
+			workspace.State = management.WorkspaceStateACTIVE
+			workspace.Endpoint = endpoint
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodDelete:
+			workspace.State = management.WorkspaceStateTERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspacesResource).
+					WithWorkspaceResource("this")("suspended", cty.BoolVal(true)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "suspended", "true"),
+					resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "data_api_url"),
+				),
+			},
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspacesResource).
+					WithWorkspaceResource("this")("suspended", cty.BoolVal(false)).
+					String(),
+				Check: resource.TestCheckResourceAttr("singlestoredb_workspace.this", "suspended", "false"),
+			},
+		},
+	})
+
+	require.Equal(t, 1, suspends, "should suspend the workspace once it is created")
+	require.Equal(t, 1, resumes, "should resume the workspace")
+}
+
+func TestWorkspaceCreateTimeout(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStatePENDING, // As if the creation were taking long.
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	begin := time.Now()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.WorkspacesResource).
+					WithWorkspaceResource("this")("timeouts", cty.ObjectVal(map[string]cty.Value{"create": cty.StringVal("3s")})).
+					String(),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Failed to wait for a workspace %s creation", workspace.WorkspaceID)),
+			},
+		},
+	})
+
+	require.Less(t, time.Since(begin), config.WorkspaceCreationTimeout, "should wait for the create timeout only")
+}
+
+func TestWorkspaceCreationWithoutWaiting(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.PENDING,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStatePENDING,
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	mu := sync.Mutex{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodDelete:
+			workspace.State = management.WorkspaceStateTERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	withoutWaiting := testutil.UpdatableConfig(examples.WorkspacesResource).
+		WithWorkspaceGroupResource("example")("wait_for_creation", cty.False).
+		WithWorkspaceResource("this")("wait_for_creation", cty.False).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: withoutWaiting,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_workspace_group.example", "wait_for_creation", "false"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", "wait_for_creation", "false"),
+					resource.TestCheckResourceAttr("singlestoredb_workspace.this", config.IDAttribute, workspace.WorkspaceID.String()),
+					resource.TestCheckNoResourceAttr("singlestoredb_workspace.this", "endpoint"),
+				),
+			},
+			{
+				Config:   withoutWaiting,
+				PlanOnly: true, // Refreshing tolerates the creation in progress.
+			},
+			{
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+
+					workspaceGroup.State = management.ACTIVE
+					workspace.State = management.WorkspaceStateACTIVE
+					workspace.Endpoint = util.Ptr("svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com")
+				},
+				Config: withoutWaiting,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace.this", "endpoint", "svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com"),
+			},
+		},
+	})
+}
+
+func TestWorkspaceKaiEnabled(t *testing.T) {
+	regions := []management.Region{
+		{
+			RegionID: uuid.MustParse("2ca3d358-021d-45ed-86cb-38b8d14ac507"),
+			Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+			Provider: management.AWS,
+		},
+	}
+
+	workspaceGroup := management.WorkspaceGroup{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:        util.Ptr(config.TestInitialWorkspaceGroupExpiresAt),
+		FirewallRanges:   util.Ptr([]string{config.TestFirewallFirewallRangeAllTraffic}),
+		Name:             config.TestInitialWorkspaceGroupName,
+		RegionID:         regions[0].RegionID,
+		State:            management.ACTIVE,
+		WorkspaceGroupID: uuid.MustParse("3ca3d359-021d-45ed-86cb-38b8d14ac507"),
+	}
+
+	workspace := management.Workspace{
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+		Endpoint:         util.Ptr("svc-3482219c-a389-4079-b18b-d50662524e8a-ddl.aws-oregon-3.svc.singlestore.com"),
+		Name:             config.TestWorkspaceName,
+		Size:             config.TestInitialWorkspaceSize,
+		State:            management.WorkspaceStateACTIVE,
+		WorkspaceGroupID: workspaceGroup.WorkspaceGroupID,
+		WorkspaceID:      uuid.MustParse("f2a1a960-8591-4156-bb26-f53f0f8e35ce"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "json")
+
+		switch {
+		case r.URL.Path == "/v1/regions" && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(regions))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaceGroups" && r.Method == http.MethodPost:
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		case r.URL.Path == "/v1/workspaces" && r.Method == http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var input management.WorkspaceCreate
+			require.NoError(t, json.Unmarshal(body, &input))
+			require.Equal(t, []management.StargateAttribute{management.MongoProxy}, util.Deref(input.StargateAttributes), "should enable Kai")
+			_, err = w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspaceGroup))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodGet:
+			_, err := w.Write(testutil.MustJSON(workspace))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaces/") && r.Method == http.MethodDelete:
+			workspace.State = management.WorkspaceStateTERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceID uuid.UUID }{WorkspaceID: workspace.WorkspaceID}))
+			require.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, "/v1/workspaceGroups/") && r.Method == http.MethodDelete:
+			workspaceGroup.State = management.TERMINATED
+			_, err := w.Write(testutil.MustJSON(struct{ WorkspaceGroupID uuid.UUID }{WorkspaceGroupID: workspaceGroup.WorkspaceGroupID}))
+			require.NoError(t, err)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	kaiEnabled := testutil.UpdatableConfig(examples.WorkspacesResource).
+		WithWorkspaceResource("this")("kai_enabled", cty.True).
+		String()
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIServiceURL: server.URL,
+		APIKey:        testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: kaiEnabled,
+				Check:  resource.TestCheckResourceAttr("singlestoredb_workspace.this", "kai_enabled", "true"),
+			},
+			{
+				Config:   kaiEnabled,
+				PlanOnly: true, // Refreshing keeps Kai enabled.
+			},
+		},
+	})
+}
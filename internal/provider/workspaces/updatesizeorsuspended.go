@@ -2,6 +2,7 @@ package workspaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/singlestore-labs/singlestore-go/management"
@@ -43,18 +44,31 @@ func scale(ctx context.Context, c management.ClientWithResponsesInterface, plan
 		return workspaceResourceModel{}, serr
 	}
 
-	workspace, werr := wait(ctx, c, id, config.WorkspaceResumeTimeout,
+	progress := newWaitProgress(id)
+	workspace, werr := wait(ctx, c, id, resizeTimeout(plan),
+		progress.observe(ctx),
 		waitConditionState(management.WorkspaceStateACTIVE),
 		waitConditionSize(desiredSize),
 		waitConditionTakesAtLeast(config.WorkspaceScaleTakesAtLeast),
 	)
 	if werr != nil {
+		werr.Detail += "\n\n" + progress.String()
+
 		return workspaceResourceModel{}, werr
 	}
 
 	return toWorkspaceResourceModel(workspace), nil
 }
 
+// resizeTimeout returns the resize_timeout of the workspace, or the update timeout, or the default one.
+func resizeTimeout(plan workspaceResourceModel) time.Duration {
+	if timeout, err := util.ParseDuration(plan.ResizeTimeout.ValueString()); err == nil {
+		return timeout
+	}
+
+	return plan.Timeouts.UpdateTimeout(config.WorkspaceResizeTimeout)
+}
+
 func resume(ctx context.Context, c management.ClientWithResponsesInterface, plan workspaceResourceModel) (workspaceResourceModel, *util.SummaryWithDetailError) {
 	id := uuid.MustParse(plan.ID.ValueString())
 	workspaceResumeResponse, err := c.PostV1WorkspacesWorkspaceIDResumeWithResponse(ctx, id)
@@ -62,7 +76,7 @@ func resume(ctx context.Context, c management.ClientWithResponsesInterface, plan
 		return workspaceResourceModel{}, serr
 	}
 
-	workspace, werr := wait(ctx, c, id, config.WorkspaceResumeTimeout,
+	workspace, werr := wait(ctx, c, id, plan.Timeouts.UpdateTimeout(config.WorkspaceResumeTimeout),
 		waitConditionState(management.WorkspaceStateACTIVE),
 	)
 	if werr != nil {
@@ -79,7 +93,7 @@ func suspend(ctx context.Context, c management.ClientWithResponsesInterface, pla
 		return workspaceResourceModel{}, serr
 	}
 
-	workspace, werr := wait(ctx, c, id, config.WorkspaceResumeTimeout,
+	workspace, werr := wait(ctx, c, id, plan.Timeouts.UpdateTimeout(config.WorkspaceSuspendTimeout),
 		waitConditionState(management.WorkspaceStateSUSPENDED),
 	)
 	if werr != nil {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
@@ -26,6 +28,10 @@ func wait(ctx context.Context, c management.ClientWithResponsesInterface, id man
 			return retry.NonRetryableError(ferr)
 		}
 
+		if code := workspace.StatusCode(); code == http.StatusUnauthorized {
+			return retry.NonRetryableError(util.StatusOK(workspace, nil)) // Retrying does not help with an expired API key.
+		}
+
 		if code := workspace.StatusCode(); code != http.StatusOK {
 			err := fmt.Errorf("failed to get workspace %s: status code %s", id, http.StatusText(code))
 
@@ -99,3 +105,87 @@ func waitConditionTakesAtLeast(d time.Duration) func(management.Workspace) error
 		return nil
 	}
 }
+
+// waitProgress records the states that a workspace goes through while waiting, so that a long wait does not look hung.
+type waitProgress struct {
+	id          management.WorkspaceID
+	begin       time.Time
+	last        string
+	transitions []string
+}
+
+func newWaitProgress(id management.WorkspaceID) *waitProgress {
+	return &waitProgress{id: id, begin: time.Now()}
+}
+
+// observe returns a condition that is always satisfied and logs every change of the state or the size.
+func (wp *waitProgress) observe(ctx context.Context) waitCondition {
+	return func(w management.Workspace) error {
+		current := fmt.Sprintf("%s with size %s", w.State, w.Size)
+		if current == wp.last {
+			return nil
+		}
+
+		elapsed := time.Since(wp.begin).Round(time.Second)
+		wp.last = current
+		wp.transitions = append(wp.transitions, fmt.Sprintf("%s after %s", current, elapsed))
+		tflog.Info(ctx, "Workspace state changed while waiting", map[string]interface{}{
+			"workspace_id": wp.id.String(),
+			"state":        string(w.State),
+			"size":         w.Size,
+			"elapsed":      elapsed.String(),
+		})
+
+		return nil
+	}
+}
+
+func (wp *waitProgress) String() string {
+	if len(wp.transitions) == 0 {
+		return fmt.Sprintf("Workspace %s was not observed in %s.", wp.id, time.Since(wp.begin).Round(time.Second))
+	}
+
+	return fmt.Sprintf("Workspace %s was observed as %s.", wp.id, strings.Join(wp.transitions, ", then "))
+}
+
+// waitTerminated waits until the workspace is either terminated or not found.
+func waitTerminated(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceID, timeout time.Duration) *util.SummaryWithDetailError {
+	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		workspace, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+		if err != nil {
+			ferr := fmt.Errorf("failed to get workspace %s: %w", id, err)
+
+			return retry.NonRetryableError(ferr)
+		}
+
+		code := workspace.StatusCode()
+		if code == http.StatusNotFound {
+			return nil
+		}
+
+		if code == http.StatusUnauthorized {
+			return retry.NonRetryableError(util.StatusOK(workspace, nil)) // Retrying does not help with an expired API key.
+		}
+
+		if code != http.StatusOK {
+			err := fmt.Errorf("failed to get workspace %s: status code %s", id, http.StatusText(code))
+
+			return retry.RetryableError(err)
+		}
+
+		if workspace.JSON200.State != management.WorkspaceStateTERMINATED {
+			err := fmt.Errorf("workspace %s state is %s", id, workspace.JSON200.State)
+
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	}); err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to wait for a workspace %s termination", id),
+			Detail:  fmt.Sprintf("Workspace is not terminated yet: %s. %s", err, config.ContactSupportLaterErrorDetail),
+		}
+	}
+
+	return nil
+}
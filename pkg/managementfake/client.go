@@ -0,0 +1,564 @@
+// Package managementfake provides an in-memory implementation of the SingleStore Management API client
+// for unit testing tools built around the provider without HTTP mocks.
+package managementfake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+)
+
+// Operation names the Management API calls that support failure injection.
+type Operation string
+
+const (
+	GetRegions           Operation = "GetV1Regions"
+	ListWorkspaceGroups  Operation = "GetV1WorkspaceGroups"
+	CreateWorkspaceGroup Operation = "PostV1WorkspaceGroups"
+	GetWorkspaceGroup    Operation = "GetV1WorkspaceGroupsWorkspaceGroupID"
+	UpdateWorkspaceGroup Operation = "PatchV1WorkspaceGroupsWorkspaceGroupID"
+	DeleteWorkspaceGroup Operation = "DeleteV1WorkspaceGroupsWorkspaceGroupID"
+	ListWorkspaces       Operation = "GetV1Workspaces"
+	CreateWorkspace      Operation = "PostV1Workspaces"
+	GetWorkspace         Operation = "GetV1WorkspacesWorkspaceID"
+	UpdateWorkspace      Operation = "PatchV1WorkspacesWorkspaceID"
+	DeleteWorkspace      Operation = "DeleteV1WorkspacesWorkspaceID"
+	ResumeWorkspace      Operation = "PostV1WorkspacesWorkspaceIDResume"
+	SuspendWorkspace     Operation = "PostV1WorkspacesWorkspaceIDSuspend"
+)
+
+const defaultWorkspaceSize = "S-00"
+
+// Failure is an injected failure of an operation.
+//
+// If Err is set, the call returns the error as a transport failure would.
+// Otherwise, the call returns StatusCode with Body.
+type Failure struct {
+	Err        error
+	StatusCode int
+	Body       string
+}
+
+// ErrNotImplemented is returned by the calls that the fake client does not implement.
+var ErrNotImplemented = errors.New("not implemented by the fake Management API client")
+
+// Client is an in-memory implementation of management.ClientWithResponsesInterface.
+//
+// Only the calls named by the Operation constants are implemented, i.e., listing the regions
+// and managing the workspace groups and the workspaces. Any other call, e.g., of the private connections
+// or GetV1OrganizationsCurrent, returns ErrNotImplemented as a transport failure.
+type Client struct {
+	management.ClientWithResponsesInterface
+
+	mu              sync.Mutex
+	regions         []management.Region
+	workspaceGroups map[uuid.UUID]management.WorkspaceGroup
+	adminPasswords  map[uuid.UUID]string
+	workspaces      map[uuid.UUID]management.Workspace
+	failures        map[Operation][]Failure
+	calls           map[Operation]int
+}
+
+var _ management.ClientWithResponsesInterface = &Client{}
+
+// New creates an empty fake client.
+func New() *Client {
+	unimplemented, err := management.NewClientWithResponses("https://fake.singlestore.invalid",
+		management.WithHTTPClient(notImplementedDoer{}),
+	)
+	if err != nil {
+		panic(err) // Cannot happen for a valid static URL.
+	}
+
+	return &Client{
+		ClientWithResponsesInterface: unimplemented,
+		workspaceGroups:              map[uuid.UUID]management.WorkspaceGroup{},
+		adminPasswords:               map[uuid.UUID]string{},
+		workspaces:                   map[uuid.UUID]management.Workspace{},
+		failures:                     map[Operation][]Failure{},
+		calls:                        map[Operation]int{},
+	}
+}
+
+// WithRegions sets the regions fixture.
+func (c *Client) WithRegions(regions ...management.Region) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.regions = append(c.regions, regions...)
+
+	return c
+}
+
+// WithWorkspaceGroups sets the workspace groups fixture.
+func (c *Client) WithWorkspaceGroups(workspaceGroups ...management.WorkspaceGroup) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, wg := range workspaceGroups {
+		c.workspaceGroups[wg.WorkspaceGroupID] = wg
+	}
+
+	return c
+}
+
+// WithWorkspaces sets the workspaces fixture.
+func (c *Client) WithWorkspaces(workspaces ...management.Workspace) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, w := range workspaces {
+		c.workspaces[w.WorkspaceID] = w
+	}
+
+	return c
+}
+
+// FailNext makes the next calls of the operation fail, one failure per call, in order.
+func (c *Client) FailNext(op Operation, failures ...Failure) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures[op] = append(c.failures[op], failures...)
+
+	return c
+}
+
+// Calls returns how many times the operation was called, including the failed calls.
+func (c *Client) Calls(op Operation) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[op]
+}
+
+// WorkspaceGroup returns the current state of the workspace group.
+func (c *Client) WorkspaceGroup(id uuid.UUID) (management.WorkspaceGroup, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wg, ok := c.workspaceGroups[id]
+
+	return wg, ok
+}
+
+// AdminPassword returns the current admin password of the workspace group.
+func (c *Client) AdminPassword(workspaceGroupID uuid.UUID) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	password, ok := c.adminPasswords[workspaceGroupID]
+
+	return password, ok
+}
+
+// Workspace returns the current state of the workspace.
+func (c *Client) Workspace(id uuid.UUID) (management.Workspace, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w, ok := c.workspaces[id]
+
+	return w, ok
+}
+
+// GetV1RegionsWithResponse lists the regions fixture.
+func (c *Client) GetV1RegionsWithResponse(_ context.Context, _ *management.GetV1RegionsParams, _ ...management.RequestEditorFn) (*management.GetV1RegionsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.GetV1RegionsResponse{}
+	code, body, err := c.respond(GetRegions, func() (int, any) {
+		return http.StatusOK, c.regions
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// GetV1WorkspaceGroupsWithResponse lists the workspace groups that are not terminated.
+func (c *Client) GetV1WorkspaceGroupsWithResponse(_ context.Context, _ *management.GetV1WorkspaceGroupsParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspaceGroupsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.GetV1WorkspaceGroupsResponse{}
+	code, body, err := c.respond(ListWorkspaceGroups, func() (int, any) {
+		workspaceGroups := []management.WorkspaceGroup{}
+		for _, wg := range c.workspaceGroups {
+			if wg.TerminatedAt == nil {
+				workspaceGroups = append(workspaceGroups, wg)
+			}
+		}
+
+		return http.StatusOK, workspaceGroups
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PostV1WorkspaceGroupsWithResponse creates an active workspace group.
+func (c *Client) PostV1WorkspaceGroupsWithResponse(_ context.Context, body management.PostV1WorkspaceGroupsJSONRequestBody, _ ...management.RequestEditorFn) (*management.PostV1WorkspaceGroupsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PostV1WorkspaceGroupsResponse{}
+	code, respBody, err := c.respond(CreateWorkspaceGroup, func() (int, any) {
+		wg := management.WorkspaceGroup{
+			AllowAllTraffic:  body.AllowAllTraffic,
+			CreatedAt:        now(),
+			ExpiresAt:        body.ExpiresAt,
+			FirewallRanges:   &body.FirewallRanges,
+			Name:             body.Name,
+			RegionID:         body.RegionID,
+			State:            management.ACTIVE,
+			UpdateWindow:     body.UpdateWindow,
+			WorkspaceGroupID: uuid.New(),
+		}
+		c.workspaceGroups[wg.WorkspaceGroupID] = wg
+
+		adminPassword := "generated-" + uuid.NewString()
+		if body.AdminPassword != nil {
+			adminPassword = *body.AdminPassword
+		}
+
+		c.adminPasswords[wg.WorkspaceGroupID] = adminPassword
+
+		return http.StatusOK, map[string]any{
+			"workspaceGroupID": wg.WorkspaceGroupID,
+			"adminPassword":    adminPassword,
+		}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, respBody, err)
+}
+
+// GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse gets the workspace group.
+func (c *Client) GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(_ context.Context, workspaceGroupID management.WorkspaceGroupID, _ *management.GetV1WorkspaceGroupsWorkspaceGroupIDParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspaceGroupsWorkspaceGroupIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.GetV1WorkspaceGroupsWorkspaceGroupIDResponse{}
+	code, body, err := c.respond(GetWorkspaceGroup, func() (int, any) {
+		wg, ok := c.workspaceGroups[workspaceGroupID]
+		if !ok {
+			return http.StatusNotFound, nil
+		}
+
+		return http.StatusOK, wg
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse updates the set fields of the workspace group.
+func (c *Client) PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(_ context.Context, workspaceGroupID management.WorkspaceGroupID, body management.PatchV1WorkspaceGroupsWorkspaceGroupIDJSONRequestBody, _ ...management.RequestEditorFn) (*management.PatchV1WorkspaceGroupsWorkspaceGroupIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PatchV1WorkspaceGroupsWorkspaceGroupIDResponse{}
+	code, respBody, err := c.respond(UpdateWorkspaceGroup, func() (int, any) {
+		wg, ok := c.workspaceGroups[workspaceGroupID]
+		if !ok || wg.TerminatedAt != nil {
+			return http.StatusNotFound, nil
+		}
+
+		if body.Name != nil {
+			wg.Name = *body.Name
+		}
+
+		if body.ExpiresAt != nil {
+			wg.ExpiresAt = body.ExpiresAt
+		}
+
+		if body.FirewallRanges != nil {
+			wg.FirewallRanges = body.FirewallRanges
+		}
+
+		if body.AllowAllTraffic != nil {
+			wg.AllowAllTraffic = body.AllowAllTraffic
+		}
+
+		if body.UpdateWindow != nil {
+			wg.UpdateWindow = body.UpdateWindow
+		}
+
+		if body.AdminPassword != nil {
+			c.adminPasswords[workspaceGroupID] = *body.AdminPassword
+		}
+
+		c.workspaceGroups[workspaceGroupID] = wg
+
+		return http.StatusOK, map[string]any{"workspaceGroupID": workspaceGroupID}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, respBody, err)
+}
+
+// DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse terminates the workspace group and its workspaces.
+func (c *Client) DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(_ context.Context, workspaceGroupID management.WorkspaceGroupID, _ *management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams, _ ...management.RequestEditorFn) (*management.DeleteV1WorkspaceGroupsWorkspaceGroupIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.DeleteV1WorkspaceGroupsWorkspaceGroupIDResponse{}
+	code, body, err := c.respond(DeleteWorkspaceGroup, func() (int, any) {
+		wg, ok := c.workspaceGroups[workspaceGroupID]
+		if !ok || wg.TerminatedAt != nil {
+			return http.StatusNotFound, nil
+		}
+
+		terminatedAt := now()
+		wg.State = management.TERMINATED
+		wg.TerminatedAt = &terminatedAt
+		c.workspaceGroups[workspaceGroupID] = wg
+
+		for id, w := range c.workspaces {
+			if w.WorkspaceGroupID == workspaceGroupID && w.TerminatedAt == nil {
+				c.workspaces[id] = terminated(w)
+			}
+		}
+
+		return http.StatusOK, map[string]any{"workspaceGroupID": workspaceGroupID}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// GetV1WorkspacesWithResponse lists the workspaces of the workspace group that are not terminated.
+func (c *Client) GetV1WorkspacesWithResponse(_ context.Context, params *management.GetV1WorkspacesParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspacesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.GetV1WorkspacesResponse{}
+	code, body, err := c.respond(ListWorkspaces, func() (int, any) {
+		workspaces := []management.Workspace{}
+		for _, w := range c.workspaces {
+			if w.WorkspaceGroupID == params.WorkspaceGroupID && w.TerminatedAt == nil {
+				workspaces = append(workspaces, w)
+			}
+		}
+
+		return http.StatusOK, workspaces
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PostV1WorkspacesWithResponse creates an active workspace in an existing workspace group.
+func (c *Client) PostV1WorkspacesWithResponse(_ context.Context, body management.PostV1WorkspacesJSONRequestBody, _ ...management.RequestEditorFn) (*management.PostV1WorkspacesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PostV1WorkspacesResponse{}
+	code, respBody, err := c.respond(CreateWorkspace, func() (int, any) {
+		wg, ok := c.workspaceGroups[body.WorkspaceGroupID]
+		if !ok || wg.TerminatedAt != nil {
+			return http.StatusNotFound, nil
+		}
+
+		size := defaultWorkspaceSize
+		if body.Size != nil {
+			size = *body.Size
+		}
+
+		w := management.Workspace{
+			CreatedAt:        now(),
+			Name:             body.Name,
+			Size:             size,
+			State:            management.WorkspaceStateACTIVE,
+			WorkspaceGroupID: body.WorkspaceGroupID,
+			WorkspaceID:      uuid.New(),
+		}
+		w.Endpoint = endpoint(w.WorkspaceID)
+		c.workspaces[w.WorkspaceID] = w
+
+		return http.StatusOK, map[string]any{"workspaceID": w.WorkspaceID}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, respBody, err)
+}
+
+// GetV1WorkspacesWorkspaceIDWithResponse gets the workspace.
+func (c *Client) GetV1WorkspacesWorkspaceIDWithResponse(_ context.Context, workspaceID management.WorkspaceID, _ *management.GetV1WorkspacesWorkspaceIDParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspacesWorkspaceIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.GetV1WorkspacesWorkspaceIDResponse{}
+	code, body, err := c.respond(GetWorkspace, func() (int, any) {
+		w, ok := c.workspaces[workspaceID]
+		if !ok {
+			return http.StatusNotFound, nil
+		}
+
+		return http.StatusOK, w
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PatchV1WorkspacesWorkspaceIDWithResponse resizes the workspace.
+func (c *Client) PatchV1WorkspacesWorkspaceIDWithResponse(_ context.Context, workspaceID management.WorkspaceID, body management.PatchV1WorkspacesWorkspaceIDJSONRequestBody, _ ...management.RequestEditorFn) (*management.PatchV1WorkspacesWorkspaceIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PatchV1WorkspacesWorkspaceIDResponse{}
+	code, respBody, err := c.respond(UpdateWorkspace, func() (int, any) {
+		w, ok := c.workspaces[workspaceID]
+		if !ok || w.TerminatedAt != nil {
+			return http.StatusNotFound, nil
+		}
+
+		if body.Size != nil {
+			w.Size = *body.Size
+		}
+
+		c.workspaces[workspaceID] = w
+
+		return http.StatusOK, map[string]any{"workspaceID": workspaceID}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, respBody, err)
+}
+
+// DeleteV1WorkspacesWorkspaceIDWithResponse terminates the workspace.
+func (c *Client) DeleteV1WorkspacesWorkspaceIDWithResponse(_ context.Context, workspaceID management.WorkspaceID, _ ...management.RequestEditorFn) (*management.DeleteV1WorkspacesWorkspaceIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.DeleteV1WorkspacesWorkspaceIDResponse{}
+	code, body, err := c.respond(DeleteWorkspace, func() (int, any) {
+		w, ok := c.workspaces[workspaceID]
+		if !ok || w.TerminatedAt != nil {
+			return http.StatusNotFound, nil
+		}
+
+		c.workspaces[workspaceID] = terminated(w)
+
+		return http.StatusOK, map[string]any{"workspaceID": workspaceID}
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PostV1WorkspacesWorkspaceIDResumeWithResponse resumes the workspace.
+func (c *Client) PostV1WorkspacesWorkspaceIDResumeWithResponse(_ context.Context, workspaceID management.WorkspaceID, _ ...management.RequestEditorFn) (*management.PostV1WorkspacesWorkspaceIDResumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PostV1WorkspacesWorkspaceIDResumeResponse{}
+	code, body, err := c.respond(ResumeWorkspace, func() (int, any) {
+		return c.transition(workspaceID, management.WorkspaceStateACTIVE)
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+// PostV1WorkspacesWorkspaceIDSuspendWithResponse suspends the workspace.
+func (c *Client) PostV1WorkspacesWorkspaceIDSuspendWithResponse(_ context.Context, workspaceID management.WorkspaceID, _ ...management.RequestEditorFn) (*management.PostV1WorkspacesWorkspaceIDSuspendResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &management.PostV1WorkspacesWorkspaceIDSuspendResponse{}
+	code, body, err := c.respond(SuspendWorkspace, func() (int, any) {
+		return c.transition(workspaceID, management.WorkspaceStateSUSPENDED)
+	})
+
+	return result, fill(&result.Body, &result.HTTPResponse, &result.JSON200, code, body, err)
+}
+
+func (c *Client) transition(workspaceID uuid.UUID, state management.WorkspaceState) (int, any) {
+	w, ok := c.workspaces[workspaceID]
+	if !ok || w.TerminatedAt != nil {
+		return http.StatusNotFound, nil
+	}
+
+	w.State = state
+	w.Endpoint = nil
+	if state == management.WorkspaceStateACTIVE {
+		resumedAt := now()
+		w.LastResumedAt = &resumedAt
+		w.Endpoint = endpoint(workspaceID)
+	}
+
+	c.workspaces[workspaceID] = w
+
+	return http.StatusOK, map[string]any{"workspaceID": workspaceID}
+}
+
+// notImplementedDoer fails the requests of the generated client that backs the calls the fake does not implement.
+type notImplementedDoer struct{}
+
+func (notImplementedDoer) Do(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrNotImplemented)
+}
+
+// respond either returns the next injected failure or calls the handler.
+func (c *Client) respond(op Operation, handler func() (int, any)) (int, []byte, error) {
+	c.calls[op]++
+
+	if failures := c.failures[op]; len(failures) > 0 {
+		c.failures[op] = failures[1:]
+		f := failures[0]
+		if f.Err != nil {
+			return 0, nil, f.Err
+		}
+
+		return f.StatusCode, []byte(f.Body), nil
+	}
+
+	code, body := handler()
+	if body == nil {
+		return code, []byte(http.StatusText(code)), nil
+	}
+
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to marshal the fake response: %w", err)
+	}
+
+	return code, result, nil
+}
+
+// fill populates the generated response type the same way the generated client does.
+func fill[J any](body *[]byte, httpResponse **http.Response, json200 **J, code int, respBody []byte, err error) error {
+	if err != nil {
+		return err
+	}
+
+	*body = respBody
+	*httpResponse = &http.Response{
+		StatusCode: code,
+		Status:     http.StatusText(code),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+
+	if code != http.StatusOK {
+		return nil
+	}
+
+	return json.Unmarshal(respBody, json200)
+}
+
+func terminated(w management.Workspace) management.Workspace {
+	terminatedAt := now()
+	w.State = management.WorkspaceStateTERMINATED
+	w.TerminatedAt = &terminatedAt
+	w.Endpoint = nil
+
+	return w
+}
+
+func endpoint(workspaceID uuid.UUID) *string {
+	result := fmt.Sprintf("svc-%s-dml.fake.svc.singlestore.com", workspaceID)
+
+	return &result
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
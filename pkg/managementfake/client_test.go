@@ -0,0 +1,138 @@
+package managementfake_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/pkg/managementfake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	regionID := uuid.MustParse("e495c7f3-b37a-4234-8e8f-f715257e3a6c")
+	c := managementfake.New().WithRegions(management.Region{
+		RegionID: regionID,
+		Region:   "GS - US West 2 (Oregon) - aws-oregon-gs1",
+		Provider: management.AWS,
+	})
+
+	regions, err := c.GetV1RegionsWithResponse(ctx, &management.GetV1RegionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, regions.StatusCode())
+	require.Len(t, *regions.JSON200, 1)
+
+	wgCreate, err := c.PostV1WorkspaceGroupsWithResponse(ctx, management.PostV1WorkspaceGroupsJSONRequestBody{
+		Name:           "group",
+		RegionID:       regionID,
+		FirewallRanges: []string{"0.0.0.0/0"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, wgCreate.JSON200)
+	require.NotNil(t, wgCreate.JSON200.AdminPassword)
+
+	wgID := wgCreate.JSON200.WorkspaceGroupID
+	wCreate, err := c.PostV1WorkspacesWithResponse(ctx, management.PostV1WorkspacesJSONRequestBody{
+		Name:             "workspace",
+		WorkspaceGroupID: wgID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, wCreate.JSON200)
+
+	wID := wCreate.JSON200.WorkspaceID
+	w, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, wID, &management.GetV1WorkspacesWorkspaceIDParams{})
+	require.NoError(t, err)
+	require.Equal(t, management.WorkspaceStateACTIVE, w.JSON200.State)
+	require.NotNil(t, w.JSON200.Endpoint)
+
+	_, err = c.PostV1WorkspacesWorkspaceIDSuspendWithResponse(ctx, wID)
+	require.NoError(t, err)
+	suspended, ok := c.Workspace(wID)
+	require.True(t, ok)
+	require.Equal(t, management.WorkspaceStateSUSPENDED, suspended.State)
+	require.Nil(t, suspended.Endpoint)
+
+	deleted, err := c.DeleteV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, wgID, &management.DeleteV1WorkspaceGroupsWorkspaceGroupIDParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode())
+
+	workspaces, err := c.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{WorkspaceGroupID: wgID})
+	require.NoError(t, err)
+	require.Empty(t, *workspaces.JSON200)
+
+	terminated, ok := c.Workspace(wID)
+	require.True(t, ok)
+	require.Equal(t, management.WorkspaceStateTERMINATED, terminated.State)
+}
+
+func TestNotFound(t *testing.T) {
+	c := managementfake.New()
+
+	w, err := c.GetV1WorkspacesWorkspaceIDWithResponse(context.Background(), uuid.New(), &management.GetV1WorkspacesWorkspaceIDParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, w.StatusCode())
+	require.Nil(t, w.JSON200)
+}
+
+func TestFailNext(t *testing.T) {
+	ctx := context.Background()
+	transportErr := errors.New("connection reset")
+	c := managementfake.New().FailNext(managementfake.ListWorkspaceGroups,
+		managementfake.Failure{StatusCode: http.StatusInternalServerError, Body: "internal"},
+		managementfake.Failure{Err: transportErr},
+	)
+
+	wgs, err := c.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, wgs.StatusCode())
+	require.Equal(t, "internal", string(wgs.Body))
+
+	_, err = c.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	require.ErrorIs(t, err, transportErr)
+
+	wgs, err = c.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, wgs.StatusCode())
+	require.Equal(t, 3, c.Calls(managementfake.ListWorkspaceGroups))
+}
+
+func TestNotImplemented(t *testing.T) {
+	c := managementfake.New()
+
+	_, err := c.GetV1PrivateConnectionsConnectionIDWithResponse(context.Background(), uuid.New(), &management.GetV1PrivateConnectionsConnectionIDParams{})
+	require.ErrorIs(t, err, managementfake.ErrNotImplemented)
+}
+
+func TestUpdateWorkspaceGroup(t *testing.T) {
+	ctx := context.Background()
+	c := managementfake.New()
+
+	wgCreate, err := c.PostV1WorkspaceGroupsWithResponse(ctx, management.PostV1WorkspaceGroupsJSONRequestBody{
+		Name:           "group",
+		RegionID:       uuid.New(),
+		FirewallRanges: []string{"0.0.0.0/0"},
+	})
+	require.NoError(t, err)
+
+	wgID := wgCreate.JSON200.WorkspaceGroupID
+	adminPassword := "fooBAR12$"
+	updateWindow := management.UpdateWindow{Day: 2, Hour: 3}
+	_, err = c.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, wgID, management.PatchV1WorkspaceGroupsWorkspaceGroupIDJSONRequestBody{
+		AdminPassword: &adminPassword,
+		UpdateWindow:  &updateWindow,
+	})
+	require.NoError(t, err)
+
+	wg, ok := c.WorkspaceGroup(wgID)
+	require.True(t, ok)
+	require.Equal(t, &updateWindow, wg.UpdateWindow)
+	require.Equal(t, "group", wg.Name, "should keep the fields that are not set")
+
+	actualAdminPassword, ok := c.AdminPassword(wgID)
+	require.True(t, ok)
+	require.Equal(t, adminPassword, actualAdminPassword)
+}